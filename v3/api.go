@@ -3,9 +3,6 @@ package v3
 import (
 	"bytes"
 	"context"
-	"crypto/hmac"
-	"crypto/sha256"
-	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -14,12 +11,14 @@ import (
 	"net/http"
 	"net/http/httputil"
 	"os"
-	"sort"
 	"strings"
 	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
+
+	"github.com/exoscale/egoscale/v3/credentials"
+	"github.com/exoscale/egoscale/v3/signer"
 )
 
 type UUID string
@@ -39,82 +38,10 @@ func ParseUUID(s string) (UUID, error) {
 
 // Wait is a helper that waits for async operation to reach the final state.
 // Final states are one of: failure, success, timeout.
-// If states argument are given, returns an error if the final state not match on of those.
+// If states argument are given, returns an *OperationFailedError if the final state not match on of those.
+// See NewWaiter for more waiting options.
 func (c Client) Wait(ctx context.Context, op *Operation, states ...OperationState) (*Operation, error) {
-	const abortErrorsCount = 5
-
-	if op == nil {
-		return nil, fmt.Errorf("operation is nil")
-	}
-
-	startTime := time.Now()
-
-	ticker := time.NewTicker(pollInterval(0))
-	defer ticker.Stop()
-
-	if op.State != OperationStatePending {
-		return op, nil
-	}
-
-	var subsequentErrors int
-	var operation *Operation
-polling:
-	for {
-		select {
-		case <-ticker.C:
-			runTime := time.Since(startTime)
-
-			if c.waitTimeout != 0 && runTime > c.waitTimeout {
-				return nil, fmt.Errorf("operation: %q: max wait timeout reached", op.ID)
-			}
-
-			newInterval := pollInterval(runTime)
-			ticker.Reset(newInterval)
-
-			o, err := c.GetOperation(ctx, op.ID)
-			if err != nil {
-				subsequentErrors++
-				if subsequentErrors >= abortErrorsCount {
-					return nil, err
-				}
-				continue
-			}
-			subsequentErrors = 0
-
-			if o.State == OperationStatePending {
-				continue
-			}
-
-			operation = o
-			break polling
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		}
-	}
-
-	if len(states) == 0 {
-		return operation, nil
-	}
-
-	for _, st := range states {
-		if operation.State == st {
-			return operation, nil
-		}
-	}
-
-	var ref OperationReference
-	if operation.Reference != nil {
-		ref = *operation.Reference
-	}
-
-	return nil,
-		fmt.Errorf("operation: %q %v, state: %s, reason: %q, message: %q",
-			operation.ID,
-			ref,
-			operation.State,
-			operation.Reason,
-			operation.Message,
-		)
+	return c.NewWaiter(WaiterOptWithStates(states...)).Wait(ctx, op)
 }
 
 func String(s string) *string {
@@ -206,83 +133,155 @@ func prepareJSONResponse(resp *http.Response, v any) error {
 	return nil
 }
 
-func (c Client) signRequest(req *http.Request) error {
-	var (
-		sigParts    []string
-		headerParts []string
-	)
-
-	var expiration = time.Now().UTC().Add(time.Minute * 10)
+func prepareJSONStream(resp *http.Response, decode func(dec *json.Decoder) error) error {
+	defer resp.Body.Close()
 
-	// Request method/URL path
-	sigParts = append(sigParts, fmt.Sprintf("%s %s", req.Method, req.URL.EscapedPath()))
-	headerParts = append(headerParts, "EXO2-HMAC-SHA256 credential="+c.apiKey)
+	return decode(json.NewDecoder(resp.Body))
+}
 
-	// Request body if present
-	body := ""
-	if req.Body != nil {
-		data, err := io.ReadAll(req.Body)
+// send returns the innermost Handler of the middleware chain, which signs and sends
+// the HTTP request of an API operation, checks its status and decodes its body.
+func (c Client) send(sign bool) Handler {
+	return func(ctx context.Context, call *Call) error {
+		// A single span covers the request sent again with refreshed credentials.
+		ctx, span := c.startOperationSpan(ctx, call.OperationID, call.Request)
+		response, retries, err := c.sendRequest(ctx, call, sign)
+		endOperationSpan(span, response, err, retries)
 		if err != nil {
 			return err
 		}
-		err = req.Body.Close()
-		if err != nil {
-			return err
+		call.Response = response
+
+		if c.trace {
+			dumpResponse(response)
+		}
+
+		if err := handleHTTPErrorResp(response); err != nil {
+			return fmt.Errorf("http response: %w", err)
 		}
-		body = string(data)
-		req.Body = io.NopCloser(bytes.NewReader(data))
+
+		if call.Stream != nil {
+			if err := prepareJSONStream(response, call.Stream); err != nil {
+				return fmt.Errorf("prepare JSON response: %w", err)
+			}
+
+			return nil
+		}
+
+		if call.Result == nil {
+			// response.Body must be closed even for no-content responses (e.g. HTTP 204)
+			// to return the underlying TCP connection to the pool.
+			return response.Body.Close()
+		}
+
+		if err := prepareJSONResponse(response, call.Result); err != nil {
+			return fmt.Errorf("prepare JSON response: %w", err)
+		}
+
+		return nil
 	}
-	sigParts = append(sigParts, body)
-
-	// Request query string parameters
-	// Important: this is order-sensitive, we have to have to sort parameters alphabetically to ensure signed
-	// values match the names listed in the "signed-query-args=" signature pragma.
-	signedParams, paramsValues := extractRequestParameters(req)
-	sigParts = append(sigParts, paramsValues)
-	if len(signedParams) > 0 {
-		headerParts = append(headerParts, "signed-query-args="+strings.Join(signedParams, ";"))
+}
+
+// sendRequest signs and sends the HTTP request of an API operation, sending it again once
+// with new credentials if they were rotated, and returns the response along with the count of retries.
+func (c Client) sendRequest(ctx context.Context, call *Call, sign bool) (*http.Response, int32, error) {
+	req := call.Request
+	// The request body is consumed when sent, it can only be sent again if it can be rewound.
+	replayable := req.Body == nil || req.GetBody != nil
+
+	var creds credentials.Value
+	if sign {
+		var err error
+		if creds, err = c.credentials.Get(); err != nil {
+			return nil, 0, fmt.Errorf("retrieve credentials: %w", err)
+		}
+		if err := c.signRequest(req, creds); err != nil {
+			return nil, 0, fmt.Errorf("sign request: %w", err)
+		}
 	}
 
-	// Request headers -- none at the moment
-	// Note: the same order-sensitive caution for query string parameters applies to headers.
-	sigParts = append(sigParts, "")
+	response, retries, err := c.roundTrip(ctx, call.OperationID, req)
+	if err != nil {
+		return nil, retries, fmt.Errorf("http client do: %w", err)
+	}
 
-	// Request expiration date (UNIX timestamp, no line return)
-	sigParts = append(sigParts, fmt.Sprint(expiration.Unix()))
-	headerParts = append(headerParts, "expires="+fmt.Sprint(expiration.Unix()))
+	// The credentials may have been rotated: send the request again once with new ones.
+	// HTTP 403 denials come from IAM policies, the credentials themselves were accepted.
+	if sign && replayable && response.StatusCode == http.StatusUnauthorized {
+		if fresh, ok := c.refreshCredentials(creds); ok {
+			_ = response.Body.Close()
+			if req.GetBody != nil {
+				if req.Body, err = req.GetBody(); err != nil {
+					return nil, retries, fmt.Errorf("rewind request body: %w", err)
+				}
+			}
+			if err := c.signRequest(req, fresh); err != nil {
+				return nil, retries, fmt.Errorf("sign request: %w", err)
+			}
+			creds = fresh
 
-	h := hmac.New(sha256.New, []byte(c.apiSecret))
-	if _, err := h.Write([]byte(strings.Join(sigParts, "\n"))); err != nil {
-		return err
+			var resent int32
+			response, resent, err = c.roundTrip(ctx, call.OperationID, req)
+			retries += resent
+			if err != nil {
+				return nil, retries, fmt.Errorf("http client do: %w", err)
+			}
+		}
+	}
+	if sign && response.StatusCode < http.StatusBadRequest {
+		c.acceptedKeys.Store(creds.APIKey, struct{}{})
 	}
-	headerParts = append(headerParts, "signature="+base64.StdEncoding.EncodeToString(h.Sum(nil)))
-
-	req.Header.Set("Authorization", strings.Join(headerParts, ","))
 
-	return nil
+	return response, retries, nil
 }
 
-// extractRequestParameters returns the list of request URL parameters names
-// and a strings concatenating the values of the parameters.
-func extractRequestParameters(req *http.Request) ([]string, string) {
-	var (
-		names  []string
-		values string
-	)
-
-	for param, values := range req.URL.Query() {
-		// Keep only parameters that hold exactly 1 value (i.e. no empty or multi-valued parameters)
-		if len(values) == 1 {
-			names = append(names, param)
-		}
+// roundTrip sends the HTTP request of an API operation, instrumenting it.
+func (c Client) roundTrip(ctx context.Context, operationID string, req *http.Request) (*http.Response, int32, error) {
+	if c.trace {
+		dumpRequest(req, operationID)
+	}
+
+	ctx, attempts := withRequestAttempts(ctx, c.clock.now)
+	start := time.Now()
+
+	response, err := c.httpClient.Do(req.WithContext(ctx))
+	latency := time.Since(start)
+	// Retry backoffs and rate limiter waits must not be accounted in the clock offset:
+	// the response is compared to the time its attempt was sent.
+	c.clock.observe(attempts.lastSent(), response)
+	retries := attempts.retries.Load()
+	c.recordOperationMetrics(ctx, operationID, req, response, err, latency)
+	if c.logger != nil {
+		c.logOperation(ctx, operationID, req, response, err, latency, retries)
 	}
-	sort.Strings(names)
 
-	for _, param := range names {
-		values += req.URL.Query().Get(param)
+	return response, retries, err
+}
+
+// isAuthFailure reports whether the API rejected the request credentials.
+func isAuthFailure(resp *http.Response) bool {
+	return resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden
+}
+
+// refreshCredentials expires the client credentials and retrieves them again,
+// returning the new credentials if they differ from the used ones.
+func (c Client) refreshCredentials(used credentials.Value) (credentials.Value, bool) {
+	c.credentials.Expire()
+
+	fresh, err := c.credentials.Get()
+	if err != nil || fresh == used {
+		return credentials.Value{}, false
 	}
 
-	return names, values
+	return fresh, true
+}
+
+// defaultSignatureValidity is the default duration request signatures are valid for.
+const defaultSignatureValidity = 10 * time.Minute
+
+// signRequest signs the request with the given credentials.
+func (c Client) signRequest(req *http.Request, creds credentials.Value) error {
+	return signer.New(creds.APIKey, creds.APISecret).Sign(req, c.signatureExpiration())
 }
 
 func dumpRequest(req *http.Request, operationID string) {
@@ -1,8 +1,12 @@
 package v3
 
 import (
+	"context"
+	"net/http"
 	"testing"
 	"time"
+
+	"github.com/exoscale/egoscale/v3/signer"
 )
 
 func TestPollInterval(t *testing.T) {
@@ -60,3 +64,21 @@ func TestPollInterval(t *testing.T) {
 		})
 	}
 }
+
+func TestClientSignatureVerified(t *testing.T) {
+	verifier := signer.NewVerifier(signer.StaticSecrets(map[string]string{"EXOtest": "secret"}))
+
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if _, err := verifier.Verify(r); err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"message":"` + err.Error() + `"}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"8f3c2b6e-3d5a-4b8e-9f61-2c7d0e1a4b5c","state":"success"}`))
+	})
+
+	if _, err := client.CreatePrivateNetwork(context.Background(), CreatePrivateNetworkRequest{Name: "test"}); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,151 @@
+package v3
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/exoscale/egoscale/v3/credentials"
+)
+
+// defaultAssumeRoleExpiryWindow is the default duration before expiration
+// assumed role credentials are reported as expired.
+const defaultAssumeRoleExpiryWindow = time.Minute
+
+// defaultAssumeRoleTimeout is the default timeout of assuming the IAM role.
+const defaultAssumeRoleTimeout = 30 * time.Second
+
+// AssumeRoleProviderName is the name of the AssumeRoleProvider.
+const AssumeRoleProviderName = "AssumeRoleProvider"
+
+// AssumeRoleProvider is a credentials.Provider retrieving temporary credentials
+// by assuming an IAM role, refreshing them shortly before they expire.
+type AssumeRoleProvider struct {
+	client       *Client
+	roleID       UUID
+	ttl          time.Duration
+	expiryWindow time.Duration
+	timeout      time.Duration
+	now          func() time.Time
+
+	mu         sync.Mutex
+	creds      credentials.Value
+	expiration time.Time
+}
+
+// AssumeRoleProviderOpt represents an AssumeRoleProvider option.
+type AssumeRoleProviderOpt func(*AssumeRoleProvider)
+
+// AssumeRoleProviderOptWithExpiryWindow returns an AssumeRoleProviderOpt setting the duration
+// before expiration the credentials are reported as expired, so they are refreshed ahead of time.
+func AssumeRoleProviderOptWithExpiryWindow(d time.Duration) AssumeRoleProviderOpt {
+	return func(p *AssumeRoleProvider) {
+		p.expiryWindow = d
+	}
+}
+
+// AssumeRoleProviderOptWithTimeout returns an AssumeRoleProviderOpt overriding the default timeout
+// of assuming the IAM role, retries included.
+func AssumeRoleProviderOptWithTimeout(timeout time.Duration) AssumeRoleProviderOpt {
+	return func(p *AssumeRoleProvider) {
+		p.timeout = timeout
+	}
+}
+
+// NewAssumeRoleProvider returns an AssumeRoleProvider assuming the given IAM role with client,
+// which must use the base credentials allowed to assume the role, for credentials valid for ttl.
+func NewAssumeRoleProvider(client *Client, roleID UUID, ttl time.Duration, opts ...AssumeRoleProviderOpt) *AssumeRoleProvider {
+	p := &AssumeRoleProvider{
+		client:       client,
+		roleID:       roleID,
+		ttl:          ttl,
+		expiryWindow: defaultAssumeRoleExpiryWindow,
+		timeout:      defaultAssumeRoleTimeout,
+		now:          time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	// Do not refresh credentials as soon as retrieved.
+	p.expiryWindow = min(p.expiryWindow, p.ttl/2)
+
+	return p
+}
+
+// NewAssumeRoleCredentials returns Credentials retrieved by assuming the given IAM role,
+// see NewAssumeRoleProvider.
+func NewAssumeRoleCredentials(client *Client, roleID UUID, ttl time.Duration, opts ...AssumeRoleProviderOpt) *credentials.Credentials {
+	return credentials.NewCredentials(NewAssumeRoleProvider(client, roleID, ttl, opts...))
+}
+
+// Retrieve assumes the IAM role, unless the current credentials are not expired.
+func (p *AssumeRoleProvider) Retrieve() (credentials.Value, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !p.isExpired() {
+		return p.creds, nil
+	}
+
+	// Credentials are retrieved with the lock held, blocking concurrent requests.
+	ctx, cancel := context.WithTimeout(context.Background(), p.timeout)
+	defer cancel()
+
+	resp, err := p.client.AssumeIAMRole(ctx, p.roleID, AssumeIAMRoleRequest{
+		Ttl: int64(p.ttl.Seconds()),
+	})
+	if err != nil {
+		return credentials.Value{}, fmt.Errorf("assume role %s: %w", p.roleID, err)
+	}
+
+	creds := credentials.Value{APIKey: resp.Key, APISecret: resp.Secret, ProviderName: AssumeRoleProviderName}
+	if !creds.IsSet() {
+		return credentials.Value{}, fmt.Errorf("assume role %s: %w", p.roleID, credentials.ErrMissingIncomplete)
+	}
+
+	expiration := p.now().Add(p.ttl)
+	if resp.ExpiresAT != "" {
+		t, err := time.Parse(time.RFC3339, resp.ExpiresAT)
+		if err != nil {
+			return credentials.Value{}, fmt.Errorf("assume role %s: parse expiration: %w", p.roleID, err)
+		}
+		expiration = t
+	}
+
+	p.creds = creds
+	p.expiration = expiration
+
+	return creds, nil
+}
+
+// IsExpired returns true if the credentials expire within the expiry window.
+func (p *AssumeRoleProvider) IsExpired() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.isExpired()
+}
+
+// Expire discards the current credentials, so that the role is assumed again on the next Retrieve call.
+func (p *AssumeRoleProvider) Expire() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.creds = credentials.Value{}
+	p.expiration = time.Time{}
+}
+
+func (p *AssumeRoleProvider) isExpired() bool {
+	return !p.creds.IsSet() || !p.now().Before(p.expiration.Add(-p.expiryWindow))
+}
+
+// ExpiresAt returns the expiration time of the current credentials.
+func (p *AssumeRoleProvider) ExpiresAt() time.Time {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.expiration
+}
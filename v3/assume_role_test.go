@@ -0,0 +1,136 @@
+package v3
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/exoscale/egoscale/v3/credentials"
+)
+
+func TestAssumeRoleProvider(t *testing.T) {
+	const roleID = UUID("4c0f3f8e-1b0e-4c5b-9e0d-7a1f2b3c4d5e")
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	var assumed int32
+	base, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/iam-role/"+string(roleID)+"/assume":
+			if !strings.Contains(r.Header.Get("Authorization"), "credential=EXOtest,") {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+
+			var req AssumeIAMRoleRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Ttl != 600 {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			n := atomic.AddInt32(&assumed, 1)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = fmt.Fprintf(w, `{"key":"EXOassumed%d","secret":"secret","expires-at":%q}`,
+				n, now.Add(10*time.Minute).Format(time.RFC3339))
+
+		case strings.Contains(r.Header.Get("Authorization"), "credential=EXOassumed"):
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = fmt.Fprintf(w, `{"id":"bb2dcf4a-3e1b-4ce9-8f3c-6a8b1d5f0a27","name":%q}`,
+				strings.TrimPrefix(strings.Split(r.Header.Get("Authorization"), ",")[0], "EXO2-HMAC-SHA256 credential="))
+
+		default:
+			w.WriteHeader(http.StatusForbidden)
+		}
+	})
+
+	provider := NewAssumeRoleProvider(base, roleID, 10*time.Minute, AssumeRoleProviderOptWithExpiryWindow(2*time.Minute))
+	provider.now = func() time.Time { return now }
+
+	client := cloneClient(base)
+	client.credentials = credentials.NewCredentials(provider)
+
+	ctx := context.Background()
+	id := UUID("bb2dcf4a-3e1b-4ce9-8f3c-6a8b1d5f0a27")
+
+	for _, test := range []struct {
+		elapsed time.Duration
+		key     string
+	}{
+		{0, "EXOassumed1"},
+		{7 * time.Minute, "EXOassumed1"},
+		// Within the expiry window.
+		{8 * time.Minute, "EXOassumed2"},
+		{8 * time.Minute, "EXOassumed2"},
+	} {
+		now = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC).Add(test.elapsed)
+
+		instance, err := client.GetInstance(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if instance.Name != test.key {
+			t.Errorf("after %v: expected request signed with %q, got %q", test.elapsed, test.key, instance.Name)
+		}
+	}
+
+	if got := atomic.LoadInt32(&assumed); got != 2 {
+		t.Errorf("expected role to be assumed twice, got %d", got)
+	}
+}
+
+func TestAssumeRoleProviderRevoked(t *testing.T) {
+	const roleID = UUID("4c0f3f8e-1b0e-4c5b-9e0d-7a1f2b3c4d5e")
+
+	var assumed int32
+	base, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/iam-role/"+string(roleID)+"/assume":
+			n := atomic.AddInt32(&assumed, 1)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = fmt.Fprintf(w, `{"key":"EXOassumed%d","secret":"secret","expires-at":%q}`,
+				n, time.Now().Add(10*time.Minute).Format(time.RFC3339))
+
+		// The first assumed credentials were revoked before expiring.
+		case strings.Contains(r.Header.Get("Authorization"), "credential=EXOassumed2,"):
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id":"bb2dcf4a-3e1b-4ce9-8f3c-6a8b1d5f0a27","name":"test"}`))
+
+		default:
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	})
+
+	client := cloneClient(base)
+	client.credentials = credentials.NewChainCredentials([]credentials.Provider{
+		NewAssumeRoleProvider(base, roleID, 10*time.Minute),
+	})
+
+	// The cached credentials are discarded on authentication failure, and the role assumed again.
+	if _, err := client.GetInstance(context.Background(), UUID("bb2dcf4a-3e1b-4ce9-8f3c-6a8b1d5f0a27")); err != nil {
+		t.Fatal(err)
+	}
+	if got := atomic.LoadInt32(&assumed); got != 2 {
+		t.Errorf("expected role to be assumed twice, got %d", got)
+	}
+}
+
+func TestAssumeRoleProviderTimeout(t *testing.T) {
+	release := make(chan struct{})
+	base, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	})
+	t.Cleanup(func() { close(release) })
+
+	provider := NewAssumeRoleProvider(base, UUID("4c0f3f8e-1b0e-4c5b-9e0d-7a1f2b3c4d5e"), 10*time.Minute,
+		AssumeRoleProviderOptWithTimeout(50*time.Millisecond))
+
+	if _, err := provider.Retrieve(); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected %v, got %v", context.DeadlineExceeded, err)
+	}
+}
@@ -0,0 +1,194 @@
+package v3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned when an API operation is rejected by an open circuit breaker.
+var ErrCircuitOpen = errors.New("circuit breaker open")
+
+// CircuitOpenError is the error returned when an API operation is rejected
+// by the circuit breaker of a zone API endpoint. It wraps both ErrCircuitOpen
+// and the last error returned by the endpoint (typically an *APIError).
+type CircuitOpenError struct {
+	// Endpoint is the zone API endpoint host.
+	Endpoint string
+	// LastErr is the last error returned by the endpoint.
+	LastErr error
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("%s: %s: last error: %v", e.Endpoint, ErrCircuitOpen, e.LastErr)
+}
+
+func (e *CircuitOpenError) Unwrap() []error { return []error{ErrCircuitOpen, e.LastErr} }
+
+// CircuitBreaker is a client-side circuit breaker keyed by zone API endpoint.
+// The circuit of an endpoint opens after a number of consecutive server (HTTP 5xx)
+// or transport errors, making API operations fail fast with a *CircuitOpenError
+// instead of waiting on a degraded endpoint. Once the open timeout elapsed,
+// a single probe request is let through (half-open state): its success closes
+// the circuit, its failure opens it again.
+// A CircuitBreaker is safe for concurrent use, and shared by cloned clients.
+type CircuitBreaker struct {
+	threshold   int
+	openTimeout time.Duration
+	now         func() time.Time
+
+	mu       sync.Mutex
+	circuits map[string]*circuit
+}
+
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+type circuit struct {
+	state    circuitState
+	failures int
+	openedAt time.Time
+	lastErr  error
+}
+
+// NewCircuitBreaker returns a CircuitBreaker opening the circuit of an endpoint
+// after threshold consecutive failures, for the openTimeout duration.
+func NewCircuitBreaker(threshold int, openTimeout time.Duration) *CircuitBreaker {
+	if threshold < 1 {
+		threshold = 1
+	}
+
+	return &CircuitBreaker{
+		threshold:   threshold,
+		openTimeout: openTimeout,
+		now:         time.Now,
+		circuits:    make(map[string]*circuit),
+	}
+}
+
+// ClientOptWithCircuitBreaker returns a ClientOpt enabling the given circuit breaker.
+func ClientOptWithCircuitBreaker(cb *CircuitBreaker) ClientOpt {
+	return func(c *Client) error {
+		c.circuitBreaker = cb
+		return nil
+	}
+}
+
+// middleware returns the Middleware applying the circuit breaker to API operation calls.
+func (cb *CircuitBreaker) middleware(next Handler) Handler {
+	return func(ctx context.Context, call *Call) error {
+		endpoint := call.Request.URL.Host
+
+		if err := cb.allow(endpoint); err != nil {
+			return err
+		}
+
+		err := next(ctx, call)
+		cb.record(endpoint, err, callOutcome(ctx, call, err))
+
+		return err
+	}
+}
+
+// allow returns a *CircuitOpenError if the circuit of the endpoint rejects requests.
+func (cb *CircuitBreaker) allow(endpoint string) error {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	c, ok := cb.circuits[endpoint]
+	if !ok {
+		return nil
+	}
+
+	switch c.state {
+	case circuitOpen:
+		if cb.now().Before(c.openedAt.Add(cb.openTimeout)) {
+			return &CircuitOpenError{Endpoint: endpoint, LastErr: c.lastErr}
+		}
+		// Let a single probe request through.
+		c.state = circuitHalfOpen
+	case circuitHalfOpen:
+		// A probe request is already in flight.
+		return &CircuitOpenError{Endpoint: endpoint, LastErr: c.lastErr}
+	}
+
+	return nil
+}
+
+// record updates the circuit of the endpoint with the outcome of a request.
+func (cb *CircuitBreaker) record(endpoint string, err error, outcome circuitOutcome) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	c, ok := cb.circuits[endpoint]
+
+	switch outcome {
+	case outcomeSuccess:
+		delete(cb.circuits, endpoint)
+
+	case outcomeUnknown:
+		// Let the next request probe the endpoint instead.
+		if ok && c.state == circuitHalfOpen {
+			c.state = circuitOpen
+		}
+
+	case outcomeFailure:
+		if !ok {
+			c = &circuit{}
+			cb.circuits[endpoint] = c
+		}
+
+		c.failures++
+		c.lastErr = err
+		if c.state == circuitHalfOpen || c.failures >= cb.threshold {
+			c.state = circuitOpen
+			c.openedAt = cb.now()
+		}
+	}
+}
+
+type circuitOutcome int
+
+const (
+	outcomeSuccess circuitOutcome = iota
+	outcomeFailure
+	outcomeUnknown
+)
+
+// callOutcome reports whether the outcome of a call denotes a degraded endpoint.
+func callOutcome(ctx context.Context, call *Call, err error) circuitOutcome {
+	if err == nil {
+		return outcomeSuccess
+	}
+
+	// The caller gave up or the request was throttled client-side,
+	// it says nothing about the endpoint health. HTTP client timeouts,
+	// also exceeding a deadline, do denote an unresponsive endpoint.
+	if ctx.Err() != nil || errors.Is(err, context.Canceled) || errors.Is(err, errRateLimiterWait) {
+		return outcomeUnknown
+	}
+
+	if call.Response != nil {
+		if call.Response.StatusCode >= http.StatusInternalServerError {
+			return outcomeFailure
+		}
+		return outcomeSuccess
+	}
+
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return outcomeFailure
+	}
+
+	// The request was not sent (e.g. signing error).
+	return outcomeUnknown
+}
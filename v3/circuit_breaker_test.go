@@ -0,0 +1,165 @@
+package v3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	var healthy atomic.Bool
+	client, attempts := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if !healthy.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"bb2dcf4a-3e1b-4ce9-8f3c-6a8b1d5f0a27"}`))
+	})
+
+	now := time.Now()
+	cb := NewCircuitBreaker(2, time.Minute)
+	cb.now = func() time.Time { return now }
+	if err := ClientOptWithCircuitBreaker(cb)(client); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	id := UUID("bb2dcf4a-3e1b-4ce9-8f3c-6a8b1d5f0a27")
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetInstance(ctx, id); !errors.Is(err, ErrInternalServerError) {
+			t.Fatalf("expected %v, got %v", ErrInternalServerError, err)
+		}
+	}
+
+	_, err := client.GetInstance(ctx, id)
+	if !errors.Is(err, ErrCircuitOpen) || !errors.Is(err, ErrInternalServerError) {
+		t.Fatalf("expected open circuit error wrapping %v, got %v", ErrInternalServerError, err)
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Errorf("expected open circuit error to wrap an *APIError, got %v", err)
+	}
+	if got := atomic.LoadInt32(attempts); got != 2 {
+		t.Errorf("expected the open circuit to fail fast, got %d requests", got)
+	}
+
+	// Once the open timeout elapsed a failing probe opens the circuit again.
+	now = now.Add(time.Minute)
+	if _, err := client.GetInstance(ctx, id); errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected probe request to be sent, got %v", err)
+	}
+	if _, err := client.GetInstance(ctx, id); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected %v after a failed probe, got %v", ErrCircuitOpen, err)
+	}
+
+	// A successful probe closes the circuit.
+	healthy.Store(true)
+	now = now.Add(time.Minute)
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetInstance(ctx, id); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := atomic.LoadInt32(attempts); got != 5 {
+		t.Errorf("expected 5 requests, got %d", got)
+	}
+}
+
+func TestCircuitBreakerPerEndpoint(t *testing.T) {
+	cb := NewCircuitBreaker(1, time.Minute)
+
+	failing, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	healthy, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	for _, client := range []*Client{failing, healthy} {
+		if err := ClientOptWithCircuitBreaker(cb)(client); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ctx := context.Background()
+	id := UUID("bb2dcf4a-3e1b-4ce9-8f3c-6a8b1d5f0a27")
+
+	for i := 0; i < 2; i++ {
+		if _, err := failing.GetInstance(ctx, id); err == nil {
+			t.Fatal("expected an error")
+		}
+	}
+	if _, err := failing.GetInstance(ctx, id); !errors.Is(err, ErrCircuitOpen) {
+		t.Errorf("expected %v, got %v", ErrCircuitOpen, err)
+	}
+
+	// Client errors do not count as failures, and other endpoints are unaffected.
+	for i := 0; i < 2; i++ {
+		if _, err := healthy.GetInstance(ctx, id); !errors.Is(err, ErrNotFound) {
+			t.Errorf("expected %v, got %v", ErrNotFound, err)
+		}
+	}
+}
+
+func TestCircuitBreakerIgnoresClientSideErrors(t *testing.T) {
+	client, attempts := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"bb2dcf4a-3e1b-4ce9-8f3c-6a8b1d5f0a27"}`))
+	},
+		ClientOptWithCircuitBreaker(NewCircuitBreaker(1, time.Minute)),
+		ClientOptWithRateLimiter(NewRateLimiter(1, 1)),
+	)
+
+	id := UUID("bb2dcf4a-3e1b-4ce9-8f3c-6a8b1d5f0a27")
+	if _, err := client.GetInstance(context.Background(), id); err != nil {
+		t.Fatal(err)
+	}
+
+	// Requests throttled or canceled client-side do not open the circuit.
+	for i := 0; i < 2; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		_, err := client.GetInstance(ctx, id)
+		cancel()
+		if !errors.Is(err, context.DeadlineExceeded) || errors.Is(err, ErrCircuitOpen) {
+			t.Fatalf("expected %v, got %v", context.DeadlineExceeded, err)
+		}
+	}
+	for i := 0; i < 2; i++ {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if _, err := client.GetInstance(ctx, id); !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected %v, got %v", context.Canceled, err)
+		}
+	}
+	if got := atomic.LoadInt32(attempts); got != 1 {
+		t.Errorf("expected 1 request, got %d", got)
+	}
+}
+
+func TestCallOutcome(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		err  error
+		want circuitOutcome
+	}{
+		{name: "success", want: outcomeSuccess},
+		{name: "canceled", err: &url.Error{Op: "Get", Err: context.Canceled}, want: outcomeUnknown},
+		{name: "rate limited", err: &url.Error{Op: "Get", Err: fmt.Errorf("%w: %w", errRateLimiterWait, context.DeadlineExceeded)}, want: outcomeUnknown},
+		{name: "HTTP client timeout", err: &url.Error{Op: "Get", Err: context.DeadlineExceeded}, want: outcomeFailure},
+		{name: "connection refused", err: &url.Error{Op: "Get", Err: errors.New("connection refused")}, want: outcomeFailure},
+		{name: "not sent", err: errors.New("signing error"), want: outcomeUnknown},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if got := callOutcome(context.Background(), &Call{}, test.err); got != test.want {
+				t.Errorf("expected outcome %d, got %d", test.want, got)
+			}
+		})
+	}
+}
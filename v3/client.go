@@ -8,13 +8,16 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"log/slog"
 	"net/http"
 	"runtime"
+	"sync"
 	"time"
 
 	"github.com/exoscale/egoscale/v3/credentials"
 	"github.com/go-playground/validator/v10"
 	"github.com/hashicorp/go-retryablehttp"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Endpoint represents a zone endpoint.
@@ -37,32 +40,25 @@ var defaultHTTPClient = func() *http.Client {
 	rc := retryablehttp.NewClient()
 	// silence client by default
 	rc.Logger = log.New(io.Discard, "", 0)
+	rc.RequestLogHook = recordRetry
 	return rc.StandardClient()
 }()
 
+// GetZoneName returns the name of the zone of the given API endpoint.
 func (c Client) GetZoneName(ctx context.Context, endpoint Endpoint) (ZoneName, error) {
-	resp, err := c.ListZones(ctx)
+	zone, err := c.findZone(ctx, string(endpoint))
 	if err != nil {
-		return "", fmt.Errorf("get zone name: list zones: %w", err)
-	}
-
-	zone, err := resp.FindZone(string(endpoint))
-	if err != nil {
-		return "", fmt.Errorf("get zone name: find zone: %w", err)
+		return "", fmt.Errorf("get zone name: %w", err)
 	}
 
 	return zone.Name, nil
 }
 
+// GetZoneAPIEndpoint returns the API endpoint of the given zone.
 func (c Client) GetZoneAPIEndpoint(ctx context.Context, zoneName ZoneName) (Endpoint, error) {
-	resp, err := c.ListZones(ctx)
-	if err != nil {
-		return "", fmt.Errorf("get zone api endpoint: list zones: %w", err)
-	}
-
-	zone, err := resp.FindZone(string(zoneName))
+	zone, err := c.findZone(ctx, string(zoneName))
 	if err != nil {
-		return "", fmt.Errorf("get zone api endpoint: find zone: %w", err)
+		return "", fmt.Errorf("get zone api endpoint: %w", err)
 	}
 
 	return zone.APIEndpoint, nil
@@ -70,14 +66,28 @@ func (c Client) GetZoneAPIEndpoint(ctx context.Context, zoneName ZoneName) (Endp
 
 // Client represents an Exoscale API client.
 type Client struct {
-	apiKey         string
-	apiSecret      string
+	credentials    *credentials.Credentials
 	userAgent      string
 	serverEndpoint string
 	httpClient     *http.Client
 	waitTimeout    time.Duration
 	validate       *validator.Validate
 	trace          bool
+	retryPolicy    *RetryPolicy
+	rateLimiter    *RateLimiter
+	logger         *slog.Logger
+	tracer         trace.Tracer
+	metrics        *apiMetrics
+	middlewares    []Middleware
+	circuitBreaker *CircuitBreaker
+	zoneRegistry   *ZoneRegistry
+	clock          *clock
+
+	// API keys of the credentials the API accepted, telling revoked credentials apart.
+	acceptedKeys *sync.Map
+
+	// Duration request signatures are valid for.
+	signatureValidity time.Duration
 
 	// A list of callbacks for modifying requests which are generated before sending over
 	// the network.
@@ -94,6 +104,8 @@ var UserAgent = getDefaultUserAgent()
 type ClientOpt func(*Client) error
 
 // ClientOptWithTrace returns a ClientOpt enabling HTTP request/response tracing.
+// Requests and responses are dumped to stderr unredacted, including credentials:
+// use ClientOptWithLogger to collect logs safely.
 func ClientOptWithTrace() ClientOpt {
 	return func(c *Client) error {
 		c.trace = true
@@ -165,19 +177,22 @@ func getDefaultUserAgent() string {
 }
 
 // NewClient returns a new Exoscale API client.
+// Credentials are retrieved when signing each request, so that the client picks up
+// rotated credentials once expired (see credentials.Provider).
 func NewClient(credentials *credentials.Credentials, opts ...ClientOpt) (*Client, error) {
-	values, err := credentials.Get()
-	if err != nil {
+	if _, err := credentials.Get(); err != nil {
 		return nil, err
 	}
 
 	client := &Client{
-		apiKey:         values.APIKey,
-		apiSecret:      values.APISecret,
+		credentials:    credentials,
 		serverEndpoint: string(CHGva2),
 		httpClient:     defaultHTTPClient,
 		validate:       validator.New(),
 		userAgent:      getDefaultUserAgent(),
+		zoneRegistry:   NewZoneRegistry(),
+		clock:          newClock(),
+		acceptedKeys:   new(sync.Map),
 	}
 
 	for _, opt := range opts {
@@ -186,6 +201,8 @@ func NewClient(credentials *credentials.Credentials, opts ...ClientOpt) (*Client
 		}
 	}
 
+	client.httpClient = client.wrapHTTPClient(client.httpClient)
+
 	return client, nil
 }
 
@@ -245,7 +262,7 @@ func (c *Client) WithTrace() *Client {
 func (c *Client) WithHttpClient(client *http.Client) *Client {
 	clone := cloneClient(c)
 
-	clone.httpClient = client
+	clone.httpClient = clone.wrapHTTPClient(client)
 
 	return clone
 }
@@ -254,7 +271,7 @@ func (c *Client) WithHttpClient(client *http.Client) *Client {
 func (c *Client) WithHTTPClient(client *http.Client) *Client {
 	clone := cloneClient(c)
 
-	clone.httpClient = client
+	clone.httpClient = clone.wrapHTTPClient(client)
 
 	return clone
 }
@@ -280,8 +297,7 @@ func (c *Client) executeRequestInterceptors(ctx context.Context, req *http.Reque
 
 func cloneClient(c *Client) *Client {
 	return &Client{
-		apiKey:              c.apiKey,
-		apiSecret:           c.apiSecret,
+		credentials:         c.credentials,
 		userAgent:           c.userAgent,
 		serverEndpoint:      c.serverEndpoint,
 		httpClient:          c.httpClient,
@@ -289,5 +305,16 @@ func cloneClient(c *Client) *Client {
 		waitTimeout:         c.waitTimeout,
 		trace:               c.trace,
 		validate:            c.validate,
+		retryPolicy:         c.retryPolicy,
+		rateLimiter:         c.rateLimiter,
+		logger:              c.logger,
+		tracer:              c.tracer,
+		metrics:             c.metrics,
+		middlewares:         c.middlewares,
+		circuitBreaker:      c.circuitBreaker,
+		zoneRegistry:        c.zoneRegistry,
+		clock:               c.clock,
+		acceptedKeys:        c.acceptedKeys,
+		signatureValidity:   c.signatureValidity,
 	}
 }
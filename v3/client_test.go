@@ -0,0 +1,36 @@
+package v3
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/exoscale/egoscale/v3/credentials"
+)
+
+// newTestClient returns a client sending its requests to a test server serving them with handler,
+// along with the count of requests served. Requests are not retried unless opts set a retry policy.
+func newTestClient(t *testing.T, handler http.HandlerFunc, opts ...ClientOpt) (*Client, *int32) {
+	t.Helper()
+
+	var requests int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		handler(w, r)
+	}))
+	t.Cleanup(ts.Close)
+
+	client, err := NewClient(
+		credentials.NewStaticCredentials("EXOtest", "secret"),
+		append([]ClientOpt{
+			ClientOptWithEndpoint(Endpoint(ts.URL)),
+			ClientOptWithRetryPolicy(RetryPolicy{MaxAttempts: 1}),
+		}, opts...)...,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client, &requests
+}
@@ -0,0 +1,118 @@
+package v3
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// clockSkewSmoothing is the weight of the last sample in the clock offset estimate.
+const clockSkewSmoothing = 4
+
+// clock provides the current time to sign requests, compensating the local clock
+// offset relative to the API estimated from the Date header of responses.
+// It is safe for concurrent use, and shared by cloned clients.
+type clock struct {
+	now func() time.Time
+
+	mu       sync.Mutex
+	enabled  bool
+	offset   time.Duration
+	observed bool
+}
+
+func newClock() *clock {
+	return &clock{now: time.Now, enabled: true}
+}
+
+// ClientOptWithSignatureValidity returns a ClientOpt setting the duration request signatures are valid for
+// (10 minutes by default).
+func ClientOptWithSignatureValidity(d time.Duration) ClientOpt {
+	return func(c *Client) error {
+		if d <= 0 {
+			return fmt.Errorf("invalid signature validity %s", d)
+		}
+		c.signatureValidity = d
+		return nil
+	}
+}
+
+// ClientOptWithClockSkewCompensation returns a ClientOpt enabling or disabling the compensation
+// of the local clock offset relative to the API when signing requests (enabled by default).
+// The offset is estimated from the Date header of API responses, compared to the time their
+// request was sent: retries of an HTTP client set with ClientOptWithHTTPClient can't be told apart
+// and skew the estimate, configure retries with ClientOptWithRetryPolicy instead.
+func ClientOptWithClockSkewCompensation(enabled bool) ClientOpt {
+	return func(c *Client) error {
+		c.clock.mu.Lock()
+		defer c.clock.mu.Unlock()
+
+		c.clock.enabled = enabled
+		return nil
+	}
+}
+
+// ClockOffset returns the estimated offset of the API clock relative to the local clock,
+// positive if the API clock is ahead.
+func (c *Client) ClockOffset() time.Duration {
+	c.clock.mu.Lock()
+	defer c.clock.mu.Unlock()
+
+	return c.clock.offset
+}
+
+// Now returns the estimated current time of the API clock.
+func (k *clock) Now() time.Time {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if !k.enabled {
+		return k.now()
+	}
+
+	return k.now().Add(k.offset)
+}
+
+// observe updates the clock offset estimate from the Date header of a response
+// to a request sent at the given time.
+func (k *clock) observe(sent time.Time, resp *http.Response) {
+	if resp == nil {
+		return
+	}
+
+	date, err := http.ParseTime(resp.Header.Get("Date"))
+	if err != nil {
+		return
+	}
+
+	received := k.now()
+	// The Date header is truncated to the second: use the middle of that second,
+	// compared to the middle of the round trip.
+	sample := date.Add(500 * time.Millisecond).Sub(sent.Add(received.Sub(sent) / 2))
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if !k.observed {
+		k.offset = sample
+		k.observed = true
+		return
+	}
+
+	k.offset += (sample - k.offset) / clockSkewSmoothing
+}
+
+// getSignatureValidity returns the duration request signatures are valid for.
+func (c Client) getSignatureValidity() time.Duration {
+	if c.signatureValidity == 0 {
+		return defaultSignatureValidity
+	}
+
+	return c.signatureValidity
+}
+
+// signatureExpiration returns the expiration time of a request signature.
+func (c Client) signatureExpiration() time.Time {
+	return c.clock.Now().UTC().Add(c.getSignatureValidity())
+}
@@ -0,0 +1,150 @@
+package v3
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/exoscale/egoscale/v3/credentials"
+)
+
+// newTestClockClient returns a client whose local clock lags an hour behind the API clock,
+// and a function returning the expiration time of the last request signature.
+func newTestClockClient(t *testing.T, serverNow time.Time, opts ...ClientOpt) (*Client, func() time.Time) {
+	t.Helper()
+
+	expiresRe := regexp.MustCompile(`expires=(\d+)`)
+	var expires time.Time
+
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		m := expiresRe.FindStringSubmatch(r.Header.Get("Authorization"))
+		if m == nil {
+			t.Errorf("missing signature expiration: %q", r.Header.Get("Authorization"))
+		} else {
+			ts, _ := strconv.ParseInt(m[1], 10, 64)
+			expires = time.Unix(ts, 0)
+		}
+
+		w.Header().Set("Date", serverNow.UTC().Format(http.TimeFormat))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"name":"test"}`))
+	}, opts...)
+	client.clock.now = func() time.Time { return serverNow.Add(-time.Hour) }
+
+	return client, func() time.Time { return expires }
+}
+
+func TestClockSkewCompensation(t *testing.T) {
+	serverNow := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	client, expires := newTestClockClient(t, serverNow)
+
+	ctx := context.Background()
+	if _, err := client.GetOrganization(ctx); err != nil {
+		t.Fatal(err)
+	}
+	// No offset estimate yet: the local clock is used.
+	if want := serverNow.Add(-time.Hour + defaultSignatureValidity); !expires().Equal(want) {
+		t.Fatalf("expected expiration %v, got %v", want, expires())
+	}
+
+	if offset := client.ClockOffset(); offset < time.Hour || offset > time.Hour+time.Second {
+		t.Fatalf("expected clock offset of ~1h, got %v", offset)
+	}
+
+	// The clock offset is shared by cloned clients.
+	if _, err := client.WithUserAgent("test").GetOrganization(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if d := expires().Sub(serverNow.Add(defaultSignatureValidity)); d < 0 || d > time.Second {
+		t.Fatalf("expected expiration ~%v, got %v", serverNow.Add(defaultSignatureValidity), expires())
+	}
+}
+
+func TestClockSkewCompensationDisabled(t *testing.T) {
+	serverNow := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	client, expires := newTestClockClient(t, serverNow,
+		ClientOptWithClockSkewCompensation(false),
+		ClientOptWithSignatureValidity(time.Minute),
+	)
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetOrganization(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		if want := serverNow.Add(-time.Hour + time.Minute); !expires().Equal(want) {
+			t.Fatalf("expected expiration %v, got %v", want, expires())
+		}
+	}
+}
+
+func TestClockObserve(t *testing.T) {
+	local := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	k := newClock()
+	k.now = func() time.Time { return local }
+
+	observe := func(offset time.Duration) {
+		resp := &http.Response{Header: http.Header{}}
+		resp.Header.Set("Date", local.Add(offset).Format(http.TimeFormat))
+		k.observe(local, resp)
+	}
+
+	observe(time.Minute)
+	if want := time.Minute + 500*time.Millisecond; k.offset != want {
+		t.Fatalf("expected offset %v, got %v", want, k.offset)
+	}
+
+	// Later samples are smoothed.
+	observe(5 * time.Minute)
+	if want := 2*time.Minute + 500*time.Millisecond; k.offset != want {
+		t.Fatalf("expected offset %v, got %v", want, k.offset)
+	}
+
+	// Responses without a valid Date header are ignored.
+	k.observe(local, &http.Response{Header: http.Header{}})
+	k.observe(local, nil)
+	if want := 2*time.Minute + 500*time.Millisecond; k.offset != want {
+		t.Fatalf("expected offset %v, got %v", want, k.offset)
+	}
+}
+
+func TestClockObserveRetries(t *testing.T) {
+	local := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	var attempts int
+
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Date", local.Format(http.TimeFormat))
+		if attempts == 1 {
+			// The clocks are in sync, but the retry of the request is delayed.
+			local = local.Add(10 * time.Minute)
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"name":"test"}`))
+	}, ClientOptWithRetryPolicy(RetryPolicy{MaxAttempts: 2, MinBackoff: time.Millisecond}))
+	client.clock.now = func() time.Time { return local }
+
+	if _, err := client.GetOrganization(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if attempts != 2 {
+		t.Fatalf("expected 2 attempts, got %d", attempts)
+	}
+	if offset := client.ClockOffset(); offset < 0 || offset > time.Second {
+		t.Fatalf("expected a clock offset below a second, got %v", offset)
+	}
+}
+
+func TestClientOptWithSignatureValidity(t *testing.T) {
+	for _, d := range []time.Duration{0, -time.Minute} {
+		if _, err := NewClient(
+			credentials.NewStaticCredentials("EXOtest", "secret"),
+			ClientOptWithSignatureValidity(d),
+		); err == nil {
+			t.Errorf("expected an error for validity %v", d)
+		}
+	}
+}
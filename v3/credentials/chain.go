@@ -2,13 +2,66 @@ package credentials
 
 import (
 	"errors"
-	"fmt"
+	"reflect"
+	"strings"
 )
 
 var (
 	ErrNoValidCredentialProviders = errors.New("no valid credential providers")
 )
 
+// ProviderError is the error returned by a provider of a chain.
+type ProviderError struct {
+	// ProviderName is the name of the provider type (e.g. "EnvProvider").
+	ProviderName string
+	Err          error
+}
+
+func (e ProviderError) Error() string {
+	return e.ProviderName + ": " + e.Err.Error()
+}
+
+func (e ProviderError) Unwrap() error { return e.Err }
+
+// ChainProviderError is the error returned when no provider of a chain returned credentials.
+// It wraps ErrNoValidCredentialProviders and the error of every provider.
+type ChainProviderError struct {
+	// Errors holds the error of every provider, in the chain order.
+	Errors []ProviderError
+}
+
+func (e *ChainProviderError) Error() string {
+	msgs := make([]string, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		msgs = append(msgs, err.Error())
+	}
+
+	if len(msgs) == 0 {
+		return "chain provider: " + ErrNoValidCredentialProviders.Error()
+	}
+
+	return "chain provider: " + ErrNoValidCredentialProviders.Error() + ": " + strings.Join(msgs, "; ")
+}
+
+func (e *ChainProviderError) Unwrap() []error {
+	errs := []error{ErrNoValidCredentialProviders}
+	for _, err := range e.Errors {
+		errs = append(errs, err)
+	}
+
+	return errs
+}
+
+// providerName returns the name of the provider type.
+func providerName(p Provider) string {
+	t := reflect.TypeOf(p)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	return t.Name()
+}
+
 // A ChainProvider will search for a provider which returns credentials
 // and cache that provider until Retrieve is called again.
 type ChainProvider struct {
@@ -30,7 +83,7 @@ func NewChainCredentials(providers []Provider) *Credentials {
 // If a provider is found it will be cached and any calls to IsExpired()
 // will return the expired state of the cached provider.
 func (c *ChainProvider) Retrieve() (Value, error) {
-	var errs = ErrNoValidCredentialProviders
+	chainErr := &ChainProviderError{}
 
 	for _, p := range c.Providers {
 		creds, err := p.Retrieve()
@@ -39,11 +92,11 @@ func (c *ChainProvider) Retrieve() (Value, error) {
 			return creds, nil
 		}
 
-		errs = fmt.Errorf("%v: %w", errs, err)
+		chainErr.Errors = append(chainErr.Errors, ProviderError{ProviderName: providerName(p), Err: err})
 	}
 	c.current = nil
 
-	return Value{}, fmt.Errorf("chain provider: %w", errs)
+	return Value{}, chainErr
 }
 
 // IsExpired will returned the expired state of the currently cached provider
@@ -55,3 +108,10 @@ func (c *ChainProvider) IsExpired() bool {
 
 	return true
 }
+
+// Expire expires the credentials cached by the current provider, if it implements Expirer.
+func (c *ChainProvider) Expire() {
+	if e, ok := c.current.(Expirer); ok {
+		e.Expire()
+	}
+}
@@ -0,0 +1,39 @@
+package credentials
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestChainProviderError(t *testing.T) {
+	t.Setenv("EXOSCALE_API_KEY", "")
+
+	creds := NewChainCredentials([]Provider{
+		&EnvProvider{},
+		NewProcessProvider([]string{"false"}),
+	})
+	_, err := creds.Get()
+
+	var chainErr *ChainProviderError
+	if !errors.As(err, &chainErr) || !errors.Is(err, ErrNoValidCredentialProviders) {
+		t.Fatalf("expected a *ChainProviderError, got %v", err)
+	}
+	if len(chainErr.Errors) != 2 ||
+		chainErr.Errors[0].ProviderName != "EnvProvider" ||
+		!errors.Is(chainErr.Errors[0], ErrMissingIncomplete) ||
+		chainErr.Errors[1].ProviderName != "ProcessProvider" {
+		t.Errorf("unexpected providers errors %+v", chainErr.Errors)
+	}
+	if creds.ProviderName() != "" {
+		t.Errorf("expected no provider name, got %q", creds.ProviderName())
+	}
+
+	t.Setenv("EXOSCALE_API_KEY", "EXOtest")
+	t.Setenv("EXOSCALE_API_SECRET", "secret")
+	if _, err := creds.Get(); err != nil {
+		t.Fatal(err)
+	}
+	if creds.ProviderName() != EnvProviderName {
+		t.Errorf("expected provider name %q, got %q", EnvProviderName, creds.ProviderName())
+	}
+}
@@ -12,6 +12,9 @@ var (
 type Value struct {
 	APIKey    string
 	APISecret string
+
+	// ProviderName is the name of the provider the credentials were retrieved from.
+	ProviderName string
 }
 
 // IsSet returns true if the credentials Value has both APIKey and APISecret.
@@ -29,6 +32,12 @@ type Provider interface {
 	IsExpired() bool
 }
 
+// Expirer is implemented by providers caching the credentials they retrieve,
+// to discard them so that the next Retrieve call retrieves them again.
+type Expirer interface {
+	Expire()
+}
+
 type Credentials struct {
 	credentials Value
 	provider    Provider
@@ -44,11 +53,16 @@ func NewCredentials(provider Provider) *Credentials {
 	return creds
 }
 
+// Expire expires the credentials, and those cached by the provider if it implements Expirer,
+// so that they are retrieved again on the next Get call.
 func (c *Credentials) Expire() {
 	c.Lock()
 	defer c.Unlock()
 
 	c.credentials = Value{}
+	if e, ok := c.provider.(Expirer); ok {
+		e.Expire()
+	}
 }
 
 func (c *Credentials) Get() (Value, error) {
@@ -67,6 +81,15 @@ func (c *Credentials) Get() (Value, error) {
 	return c.credentials, nil
 }
 
+// ProviderName returns the name of the provider the current credentials were retrieved from,
+// or an empty string if they were not retrieved yet.
+func (c *Credentials) ProviderName() string {
+	c.RLock()
+	defer c.RUnlock()
+
+	return c.credentials.ProviderName
+}
+
 func (c *Credentials) IsExpired() bool {
 	c.RLock()
 	defer c.RUnlock()
@@ -78,6 +101,11 @@ func (c *Credentials) retrieve() error {
 	c.Lock()
 	defer c.Unlock()
 
+	// The credentials may have been retrieved by a concurrent call meanwhile.
+	if c.credentials.IsSet() && !c.provider.IsExpired() {
+		return nil
+	}
+
 	v, err := c.provider.Retrieve()
 	if err != nil {
 		return err
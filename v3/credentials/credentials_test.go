@@ -0,0 +1,59 @@
+package credentials
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// countingProvider is a Provider counting its Retrieve calls, expired until retrieved twice.
+// The first callers of IsExpired wait for each other, so that they all find the credentials expired.
+type countingProvider struct {
+	callers   int32
+	checked   atomic.Int32
+	ready     chan struct{}
+	retrieved atomic.Int32
+}
+
+func (p *countingProvider) Retrieve() (Value, error) {
+	p.retrieved.Add(1)
+
+	return Value{APIKey: "EXOtest", APISecret: "secret"}, nil
+}
+
+func (p *countingProvider) IsExpired() bool {
+	switch n := p.checked.Add(1); {
+	case n == p.callers:
+		close(p.ready)
+	case n < p.callers:
+		<-p.ready
+	}
+
+	return p.retrieved.Load() < 2
+}
+
+func TestCredentialsConcurrentGet(t *testing.T) {
+	const callers = 10
+	provider := &countingProvider{callers: callers, ready: make(chan struct{})}
+	creds := NewCredentials(provider)
+	if _, err := creds.Get(); err != nil {
+		t.Fatal(err)
+	}
+
+	// The credentials expired: concurrent calls retrieve them once.
+	var wg sync.WaitGroup
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := creds.Get(); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := provider.retrieved.Load(); got != 2 {
+		t.Errorf("expected credentials to be retrieved once again, got %d retrievals", got)
+	}
+}
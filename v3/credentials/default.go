@@ -0,0 +1,40 @@
+package credentials
+
+import (
+	"os"
+	"strings"
+)
+
+// NewDefaultCredentials returns Credentials retrieved from the first of the following providers
+// returning credentials, in this order:
+//  1. the EXOSCALE_API_KEY and EXOSCALE_API_SECRET environment variables (EnvProvider)
+//  2. the Exoscale CLI configuration file (FileProvider): the file set by the EXOSCALE_CONFIG
+//     environment variable or found in the default locations, using the account set by the
+//     EXOSCALE_ACCOUNT environment variable or the default account
+//  3. the output of the command set by the EXOSCALE_CREDENTIALS_PROCESS environment variable
+//     (ProcessProvider). The variable holds the program and its arguments separated by white spaces,
+//     run without a shell: quotes and escapes are not interpreted, so arguments can't contain
+//     white spaces (use a wrapper script instead)
+//
+// The environment variables configuring the providers are read once, when calling NewDefaultCredentials.
+// The name of the provider the credentials were retrieved from is reported by Value.ProviderName.
+func NewDefaultCredentials() *Credentials {
+	var fileOpts []FileOpt
+	if filename := os.Getenv("EXOSCALE_CONFIG"); filename != "" {
+		fileOpts = append(fileOpts, FileOptWithFilename(filename))
+	}
+	if account := os.Getenv("EXOSCALE_ACCOUNT"); account != "" {
+		fileOpts = append(fileOpts, FileOptWithAccount(account))
+	}
+
+	providers := []Provider{
+		&EnvProvider{},
+		NewFileProvider(fileOpts...),
+	}
+
+	if command := strings.Fields(os.Getenv("EXOSCALE_CREDENTIALS_PROCESS")); len(command) > 0 {
+		providers = append(providers, NewProcessProvider(command))
+	}
+
+	return NewChainCredentials(providers)
+}
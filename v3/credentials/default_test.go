@@ -0,0 +1,89 @@
+package credentials
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeTestConfig(t *testing.T, config string) string {
+	t.Helper()
+
+	filename := filepath.Join(t.TempDir(), "exoscale.toml")
+	if err := os.WriteFile(filename, []byte(config), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return filename
+}
+
+func TestNewDefaultCredentials(t *testing.T) {
+	filename := writeTestConfig(t, `
+defaultAccount = "default"
+
+[[accounts]]
+name = "default"
+key = "EXOdefault"
+secret = "secret"
+
+[[accounts]]
+name = "other"
+key = "EXOother"
+secret = "secret"
+`)
+
+	for _, test := range []struct {
+		name     string
+		env      map[string]string
+		key      string
+		provider string
+	}{
+		{
+			name: "env",
+			env: map[string]string{
+				"EXOSCALE_API_KEY":    "EXOenv",
+				"EXOSCALE_API_SECRET": "secret",
+				"EXOSCALE_CONFIG":     filename,
+			},
+			key:      "EXOenv",
+			provider: EnvProviderName,
+		},
+		{
+			name:     "file",
+			env:      map[string]string{"EXOSCALE_CONFIG": filename},
+			key:      "EXOdefault",
+			provider: FileProviderName,
+		},
+		{
+			name:     "file account",
+			env:      map[string]string{"EXOSCALE_CONFIG": filename, "EXOSCALE_ACCOUNT": "other"},
+			key:      "EXOother",
+			provider: FileProviderName,
+		},
+		{
+			name: "process",
+			env: map[string]string{
+				"EXOSCALE_CONFIG":              filepath.Join(t.TempDir(), "missing.toml"),
+				"EXOSCALE_CREDENTIALS_PROCESS": `echo {"api-key":"EXOprocess","api-secret":"secret"}`,
+			},
+			key:      "EXOprocess",
+			provider: ProcessProviderName,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			for _, k := range []string{
+				"EXOSCALE_API_KEY", "EXOSCALE_API_SECRET", "EXOSCALE_CONFIG", "EXOSCALE_ACCOUNT", "EXOSCALE_CREDENTIALS_PROCESS",
+			} {
+				t.Setenv(k, test.env[k])
+			}
+
+			v, err := NewDefaultCredentials().Get()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if v.APIKey != test.key || v.ProviderName != test.provider {
+				t.Errorf("expected %q from %s, got %q from %s", test.key, test.provider, v.APIKey, v.ProviderName)
+			}
+		})
+	}
+}
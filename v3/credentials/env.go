@@ -2,6 +2,10 @@ package credentials
 
 import "os"
 
+// EnvProviderName is the name of the EnvProvider.
+const EnvProviderName = "EnvProvider"
+
+// An EnvProvider retrieves credentials from the EXOSCALE_API_KEY and EXOSCALE_API_SECRET environment variables.
 type EnvProvider struct {
 	retrieved bool
 }
@@ -15,8 +19,9 @@ func (e *EnvProvider) Retrieve() (Value, error) {
 	e.retrieved = false
 
 	v := Value{
-		APIKey:    os.Getenv("EXOSCALE_API_KEY"),
-		APISecret: os.Getenv("EXOSCALE_API_SECRET"),
+		APIKey:       os.Getenv("EXOSCALE_API_KEY"),
+		APISecret:    os.Getenv("EXOSCALE_API_SECRET"),
+		ProviderName: EnvProviderName,
 	}
 
 	if !v.IsSet() {
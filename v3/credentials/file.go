@@ -5,10 +5,14 @@ import (
 	"os"
 	"os/user"
 	"path"
+	"time"
 
 	"github.com/spf13/viper"
 )
 
+// defaultSecretCommandTimeout is the default timeout of an account secret command.
+const defaultSecretCommandTimeout = 30 * time.Second
+
 type FileOpt func(*FileProvider)
 
 // FileOptWithFilename returns a FileOpt overriding the default filename.
@@ -25,45 +29,99 @@ func FileOptWithAccount(account string) FileOpt {
 	}
 }
 
-type FileProvider struct {
-	filename  string
-	account   string
-	retrieved bool
+// FileOptWithSecretCommandTimeout returns a FileOpt overriding the default timeout
+// of the account secret command.
+func FileOptWithSecretCommandTimeout(timeout time.Duration) FileOpt {
+	return func(f *FileProvider) {
+		f.secretCommandTimeout = timeout
+	}
+}
+
+// FileProviderName is the name of the FileProvider.
+const FileProviderName = "FileProvider"
 
-	// TODO: export some fields from the config like: default zone...etc.
+// A FileProvider retrieves credentials from an account of the Exoscale CLI configuration file.
+// If the account has no secret but a secret command, the secret is the output of the command.
+type FileProvider struct {
+	filename             string
+	account              string
+	secretCommandTimeout time.Duration
+	retrieved            bool
 }
 
-func NewFileCredentials(opts ...FileOpt) *Credentials {
-	fp := &FileProvider{}
+// NewFileProvider returns a new FileProvider.
+func NewFileProvider(opts ...FileOpt) *FileProvider {
+	fp := &FileProvider{
+		secretCommandTimeout: defaultSecretCommandTimeout,
+	}
 	for _, opt := range opts {
 		opt(fp)
 	}
-	return NewCredentials(fp)
+	return fp
+}
+
+func NewFileCredentials(opts ...FileOpt) *Credentials {
+	return NewCredentials(NewFileProvider(opts...))
 }
 
 func (f *FileProvider) Retrieve() (Value, error) {
 	f.retrieved = false
 
-	viperConf, err := f.retrieveViperConfig()
+	account, err := f.Account()
 	if err != nil {
 		return Value{}, err
 	}
 
+	v := Value{
+		APIKey:       account.Key,
+		APISecret:    account.Secret,
+		ProviderName: FileProviderName,
+	}
+
+	if v.APISecret == "" && len(account.SecretCommand) > 0 {
+		timeout := f.secretCommandTimeout
+		if timeout == 0 {
+			timeout = defaultSecretCommandTimeout
+		}
+
+		secret, err := runCommand(account.SecretCommand, timeout)
+		if err != nil {
+			return Value{}, fmt.Errorf("file provider: account %q: secret command: %w", account.Name, err)
+		}
+		v.APISecret = secret
+	}
+
+	if !v.IsSet() {
+		return Value{}, fmt.Errorf("file provider: account %q: %w", account.Name, ErrMissingIncomplete)
+	}
+
+	f.retrieved = true
+
+	return v, nil
+}
+
+// Account returns the account of the configuration file, the default one unless overridden.
+func (f *FileProvider) Account() (Account, error) {
+	viperConf, err := f.retrieveViperConfig()
+	if err != nil {
+		return Account{}, err
+	}
+
 	if err := viperConf.ReadInConfig(); err != nil {
-		return Value{}, err
+		return Account{}, err
 	}
 
 	config := Config{}
 	if err := viperConf.Unmarshal(&config); err != nil {
-		return Value{}, fmt.Errorf("file provider: couldn't read config: %w", err)
+		return Account{}, fmt.Errorf("file provider: couldn't read config: %w", err)
 	}
 
 	if len(config.Accounts) == 0 {
-		return Value{}, fmt.Errorf("file provider: no accounts were found into %q", viper.ConfigFileUsed())
+		return Account{}, fmt.Errorf("file provider: no accounts were found into %q", viperConf.ConfigFileUsed())
 	}
 
 	if f.account == "" && config.DefaultAccount == "" {
-		return Value{}, fmt.Errorf("file provider: no account defined")
+		return Account{}, fmt.Errorf("file provider: no account defined")
 	}
 
 	accountName := config.DefaultAccount
@@ -71,26 +129,13 @@ func (f *FileProvider) Retrieve() (Value, error) {
 		accountName = f.account
 	}
 
-	account := Account{}
-	for i, a := range config.Accounts {
+	for _, a := range config.Accounts {
 		if a.Name == accountName {
-			account = config.Accounts[i]
-			break
+			return a, nil
 		}
 	}
 
-	v := Value{
-		APIKey:    account.Key,
-		APISecret: account.Secret,
-	}
-
-	if !v.IsSet() {
-		return Value{}, fmt.Errorf("file provider: account %q: %w", accountName, ErrMissingIncomplete)
-	}
-
-	f.retrieved = true
-
-	return v, nil
+	return Account{}, fmt.Errorf("file provider: account %q not found: %w", accountName, ErrMissingIncomplete)
 }
 
 // IsExpired returns if the shared credentials have expired.
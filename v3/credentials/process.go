@@ -0,0 +1,156 @@
+package credentials
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultProcessTimeout is the default timeout of a credentials process.
+const defaultProcessTimeout = 30 * time.Second
+
+// defaultProcessExpiryWindow is the default duration before expiration
+// credentials retrieved from a process are reported as expired.
+const defaultProcessExpiryWindow = time.Minute
+
+// ProcessOutput is the JSON document a credentials process writes to its standard output.
+type ProcessOutput struct {
+	APIKey    string `json:"api-key"`
+	APISecret string `json:"api-secret"`
+	// Expiration is the optional RFC 3339 expiration time of the credentials,
+	// which never expire if unset.
+	Expiration *time.Time `json:"expiration,omitempty"`
+}
+
+type ProcessOpt func(*ProcessProvider)
+
+// ProcessOptWithTimeout returns a ProcessOpt overriding the default process timeout.
+func ProcessOptWithTimeout(timeout time.Duration) ProcessOpt {
+	return func(p *ProcessProvider) {
+		p.timeout = timeout
+	}
+}
+
+// ProcessOptWithExpiryWindow returns a ProcessOpt setting the duration before expiration
+// the credentials are reported as expired, so they are refreshed ahead of time.
+func ProcessOptWithExpiryWindow(d time.Duration) ProcessOpt {
+	return func(p *ProcessProvider) {
+		p.expiryWindow = d
+	}
+}
+
+// ProcessProviderName is the name of the ProcessProvider.
+const ProcessProviderName = "ProcessProvider"
+
+// A ProcessProvider retrieves credentials from the output of an external process (see ProcessOutput),
+// running it again once the credentials expire.
+type ProcessProvider struct {
+	command      []string
+	timeout      time.Duration
+	expiryWindow time.Duration
+	now          func() time.Time
+
+	mu         sync.Mutex
+	retrieved  bool
+	expiration time.Time
+}
+
+// NewProcessProvider returns a new ProcessProvider running the given command.
+func NewProcessProvider(command []string, opts ...ProcessOpt) *ProcessProvider {
+	p := &ProcessProvider{
+		command:      command,
+		timeout:      defaultProcessTimeout,
+		expiryWindow: defaultProcessExpiryWindow,
+		now:          time.Now,
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+func NewProcessCredentials(command []string, opts ...ProcessOpt) *Credentials {
+	return NewCredentials(NewProcessProvider(command, opts...))
+}
+
+// Retrieve runs the process and parses the credentials from its output.
+func (p *ProcessProvider) Retrieve() (Value, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.retrieved = false
+
+	if len(p.command) == 0 {
+		return Value{}, fmt.Errorf("process provider: no command defined")
+	}
+
+	out, err := runCommand(p.command, p.timeout)
+	if err != nil {
+		return Value{}, fmt.Errorf("process provider: %w", err)
+	}
+
+	var output ProcessOutput
+	if err := json.Unmarshal([]byte(out), &output); err != nil {
+		return Value{}, fmt.Errorf("process provider: couldn't parse output: %w", err)
+	}
+
+	v := Value{
+		APIKey:       output.APIKey,
+		APISecret:    output.APISecret,
+		ProviderName: ProcessProviderName,
+	}
+
+	if !v.IsSet() {
+		return Value{}, fmt.Errorf("process provider: %w", ErrMissingIncomplete)
+	}
+
+	p.expiration = time.Time{}
+	if output.Expiration != nil {
+		p.expiration = *output.Expiration
+	}
+	p.retrieved = true
+
+	return v, nil
+}
+
+// IsExpired returns if the credentials have not been retrieved,
+// or expire within the expiry window.
+func (p *ProcessProvider) IsExpired() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !p.retrieved {
+		return true
+	}
+
+	return !p.expiration.IsZero() && !p.now().Before(p.expiration.Add(-p.expiryWindow))
+}
+
+// runCommand runs the command and returns its trimmed output,
+// or an error including its standard error output.
+func runCommand(command []string, timeout time.Duration) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, command[0], command[1:]...) //nolint:gosec
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			err = fmt.Errorf("%w after %s", ctx.Err(), timeout)
+		}
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("%w: %s", err, msg)
+		}
+		return "", err
+	}
+
+	return strings.TrimSpace(stdout.String()), nil
+}
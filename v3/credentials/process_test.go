@@ -0,0 +1,46 @@
+package credentials
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestProcessProvider(t *testing.T) {
+	expiration := time.Now().Add(90 * time.Second).UTC().Format(time.RFC3339)
+	dir := t.TempDir()
+	counter := filepath.Join(dir, "counter")
+
+	// The process outputs credentials with a new key each time it runs.
+	script := `echo x >> ` + counter + `; n=$(wc -l < ` + counter + ` | tr -d ' '); ` +
+		`echo '{"api-key":"EXO'$n'","api-secret":"secret","expiration":"` + expiration + `"}'`
+
+	creds := NewProcessCredentials([]string{"sh", "-c", script},
+		ProcessOptWithExpiryWindow(time.Minute))
+	v, err := creds.Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.APIKey != "EXO1" || v.APISecret != "secret" {
+		t.Errorf("unexpected credentials %+v", v)
+	}
+	if v, _ := creds.Get(); v.APIKey != "EXO1" {
+		t.Errorf("expected credentials to be cached, got %q", v.APIKey)
+	}
+
+	// Credentials expiring within the expiry window are retrieved again.
+	creds = NewProcessCredentials([]string{"sh", "-c", script},
+		ProcessOptWithExpiryWindow(2*time.Minute))
+	if v, _ := creds.Get(); v.APIKey != "EXO2" {
+		t.Errorf("unexpected credentials %+v", v)
+	}
+	if v, _ := creds.Get(); v.APIKey != "EXO3" {
+		t.Errorf("expected expired credentials to be retrieved again, got %q", v.APIKey)
+	}
+
+	_, err = NewProcessCredentials([]string{"sh", "-c", "echo '{}'"}).Get()
+	if !errors.Is(err, ErrMissingIncomplete) {
+		t.Errorf("expected %v, got %v", ErrMissingIncomplete, err)
+	}
+}
@@ -1,5 +1,8 @@
 package credentials
 
+// StaticProviderName is the name of the StaticProvider.
+const StaticProviderName = "StaticProvider"
+
 // A StaticProvider is a set of credentials which are set programmatically,
 // and will never expire.
 type StaticProvider struct {
@@ -9,7 +12,7 @@ type StaticProvider struct {
 
 func NewStaticCredentials(apiKey, apiSecret string) *Credentials {
 	return NewCredentials(
-		&StaticProvider{creds: Value{APIKey: apiKey, APISecret: apiSecret}},
+		&StaticProvider{creds: Value{APIKey: apiKey, APISecret: apiSecret, ProviderName: StaticProviderName}},
 	)
 }
 
@@ -0,0 +1,132 @@
+package credentials
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// KeyFilesProviderName is the name of the KeyFilesProvider.
+const KeyFilesProviderName = "KeyFilesProvider"
+
+// A KeyFilesProvider retrieves credentials from a file containing the API key and another
+// containing the API secret (e.g. mounted from a Kubernetes secret), watching their
+// directories to report the credentials as expired once the files changed.
+// Its Close method must be called to stop watching the files.
+type KeyFilesProvider struct {
+	keyFile    string
+	secretFile string
+	watcher    *fsnotify.Watcher
+	changed    atomic.Bool
+	done       chan struct{}
+}
+
+// NewKeyFilesProvider returns a new KeyFilesProvider reading the given files.
+func NewKeyFilesProvider(keyFile, secretFile string) (*KeyFilesProvider, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("key files provider: %w", err)
+	}
+
+	// Watch the directories rather than the files, which may be replaced (e.g. Kubernetes
+	// secret volumes atomically swap a symlink to the directory of the files).
+	for _, dir := range []string{filepath.Dir(keyFile), filepath.Dir(secretFile)} {
+		if err := watcher.Add(dir); err != nil {
+			_ = watcher.Close()
+			return nil, fmt.Errorf("key files provider: watch %q: %w", dir, err)
+		}
+	}
+
+	p := &KeyFilesProvider{
+		keyFile:    keyFile,
+		secretFile: secretFile,
+		watcher:    watcher,
+		done:       make(chan struct{}),
+	}
+	p.changed.Store(true)
+
+	go p.watch()
+
+	return p, nil
+}
+
+// NewKeyFilesCredentials returns Credentials read from the given files, see NewKeyFilesProvider.
+// The returned function stops watching the files.
+func NewKeyFilesCredentials(keyFile, secretFile string) (*Credentials, func() error, error) {
+	p, err := NewKeyFilesProvider(keyFile, secretFile)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return NewCredentials(p), p.Close, nil
+}
+
+func (p *KeyFilesProvider) watch() {
+	defer close(p.done)
+
+	for {
+		select {
+		case event, ok := <-p.watcher.Events:
+			if !ok {
+				return
+			}
+			if !event.Has(fsnotify.Chmod) {
+				p.changed.Store(true)
+			}
+		case _, ok := <-p.watcher.Errors:
+			if !ok {
+				return
+			}
+			// Events may have been missed: read the files again.
+			p.changed.Store(true)
+		}
+	}
+}
+
+// Retrieve reads the credentials from the files.
+func (p *KeyFilesProvider) Retrieve() (Value, error) {
+	// Reset first so that a change occurring while reading is not missed.
+	p.changed.Store(false)
+
+	key, err := os.ReadFile(p.keyFile)
+	if err != nil {
+		p.changed.Store(true)
+		return Value{}, fmt.Errorf("key files provider: %w", err)
+	}
+
+	secret, err := os.ReadFile(p.secretFile)
+	if err != nil {
+		p.changed.Store(true)
+		return Value{}, fmt.Errorf("key files provider: %w", err)
+	}
+
+	v := Value{
+		APIKey:       strings.TrimSpace(string(key)),
+		APISecret:    strings.TrimSpace(string(secret)),
+		ProviderName: KeyFilesProviderName,
+	}
+
+	if !v.IsSet() {
+		p.changed.Store(true)
+		return Value{}, fmt.Errorf("key files provider: %w", ErrMissingIncomplete)
+	}
+
+	return v, nil
+}
+
+// IsExpired returns if the files changed since the credentials were retrieved.
+func (p *KeyFilesProvider) IsExpired() bool {
+	return p.changed.Load()
+}
+
+// Close stops watching the files.
+func (p *KeyFilesProvider) Close() error {
+	err := p.watcher.Close()
+	<-p.done
+
+	return err
+}
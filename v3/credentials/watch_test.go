@@ -0,0 +1,63 @@
+package credentials
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestKeyFilesProvider(t *testing.T) {
+	dir := t.TempDir()
+	keyFile, secretFile := filepath.Join(dir, "key"), filepath.Join(dir, "secret")
+
+	write := func(key string) {
+		t.Helper()
+		if err := os.WriteFile(keyFile, []byte(key+"\n"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(secretFile, []byte("secret\n"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("EXOold")
+
+	creds, closeFn, err := NewKeyFilesCredentials(keyFile, secretFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeFn() //nolint:errcheck
+
+	if v, err := creds.Get(); err != nil || v.APIKey != "EXOold" || v.APISecret != "secret" {
+		t.Fatalf("unexpected credentials %+v (error: %v)", v, err)
+	}
+
+	write("EXOnew")
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		v, err := creds.Get()
+		if err == nil && v.APIKey == "EXOnew" {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected credentials to be reloaded, got %+v (error: %v)", v, err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	files, err := NewKeyFilesProvider(keyFile, secretFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer files.Close() //nolint:errcheck
+
+	chain := NewChainCredentials([]Provider{
+		&EnvProvider{},
+		NewProcessProvider([]string{"false"}),
+		files,
+	})
+	t.Setenv("EXOSCALE_API_KEY", "")
+	if v, err := chain.Get(); err != nil || v.APIKey != "EXOnew" {
+		t.Errorf("unexpected chained credentials %+v (error: %v)", v, err)
+	}
+}
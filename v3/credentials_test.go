@@ -0,0 +1,128 @@
+package v3
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/exoscale/egoscale/v3/credentials"
+)
+
+// rotatingProvider is a credentials.Provider returning the current key, expiring on demand.
+type rotatingProvider struct {
+	mu         sync.Mutex
+	key        string
+	expired    bool
+	retrievals int
+}
+
+func (p *rotatingProvider) Retrieve() (credentials.Value, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.expired = false
+	p.retrievals++
+	return credentials.Value{APIKey: p.key, APISecret: "secret"}, nil
+}
+
+func (p *rotatingProvider) IsExpired() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.expired
+}
+
+func (p *rotatingProvider) rotate(key string, expire bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.key = key
+	p.expired = expire
+}
+
+func TestClientCredentialsRotation(t *testing.T) {
+	var validKey atomic.Value
+	validKey.Store("EXOold")
+
+	client, requests := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Method == http.MethodPost && !strings.Contains(string(body), "test") {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if !strings.Contains(r.Header.Get("Authorization"), "credential="+validKey.Load().(string)+",") {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"d3e8d1a6-7a3c-4d5e-9b1f-2d4c5e6f7a8b","state":"success"}`))
+	})
+
+	provider := &rotatingProvider{key: "EXOold"}
+	client.credentials = credentials.NewCredentials(provider)
+
+	ctx := context.Background()
+	if _, err := client.CreateSecurityGroup(ctx, CreateSecurityGroupRequest{Name: "test"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Expired credentials are retrieved again before signing.
+	provider.rotate("EXOnew", true)
+	validKey.Store("EXOnew")
+	atomic.StoreInt32(requests, 0)
+	if _, err := client.CreateSecurityGroup(ctx, CreateSecurityGroupRequest{Name: "test"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := atomic.LoadInt32(requests); got != 1 {
+		t.Errorf("expected 1 request, got %d", got)
+	}
+
+	// Credentials revoked before expiring are retrieved again on authentication failure.
+	provider.rotate("EXOnewer", false)
+	validKey.Store("EXOnewer")
+	atomic.StoreInt32(requests, 0)
+	if _, err := client.CreateSecurityGroup(ctx, CreateSecurityGroupRequest{Name: "test"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := atomic.LoadInt32(requests); got != 2 {
+		t.Errorf("expected the request to be sent again, got %d requests", got)
+	}
+
+	// Unchanged credentials are not sent again.
+	validKey.Store("EXOrevoked")
+	atomic.StoreInt32(requests, 0)
+	if _, err := client.CreateSecurityGroup(ctx, CreateSecurityGroupRequest{Name: "test"}); !errors.Is(err, ErrUnauthorized) {
+		t.Errorf("expected %v, got %v", ErrUnauthorized, err)
+	}
+	if got := atomic.LoadInt32(requests); got != 1 {
+		t.Errorf("expected 1 request, got %d", got)
+	}
+}
+
+func TestClientCredentialsPermissionDenied(t *testing.T) {
+	client, requests := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	provider := &rotatingProvider{key: "EXOtest"}
+	client.credentials = credentials.NewCredentials(provider)
+
+	// Requests denied by IAM policies do not retrieve the credentials again.
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetInstance(context.Background(), UUID("bb2dcf4a-3e1b-4ce9-8f3c-6a8b1d5f0a27")); !errors.Is(err, ErrForbidden) {
+			t.Errorf("expected %v, got %v", ErrForbidden, err)
+		}
+	}
+	if got := atomic.LoadInt32(requests); got != 2 {
+		t.Errorf("expected 2 requests, got %d", got)
+	}
+	if provider.retrievals != 1 {
+		t.Errorf("expected credentials to be retrieved once, got %d retrievals", provider.retrievals)
+	}
+}
@@ -32,14 +32,17 @@ func Generate(doc libopenapi.Document, path, packageName string) error {
 		"fmt"
 		"io"
 		"log"
+		"log/slog"
 		"net/http"
 		"context"
 		"runtime"
+		"sync"
 		"time"
 
 		"github.com/exoscale/egoscale/v3/credentials"
 		"github.com/go-playground/validator/v10"
 		"github.com/hashicorp/go-retryablehttp"
+		"go.opentelemetry.io/otel/trace"
 	)
 	`, packageName))
 
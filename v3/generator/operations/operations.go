@@ -6,6 +6,8 @@ import (
 	"go/format"
 	"log/slog"
 	"os"
+	"slices"
+	"sort"
 	"strings"
 	"text/template"
 
@@ -32,6 +34,7 @@ func Generate(doc libopenapi.Document, path, packageName string) error {
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net"
 	"net/http"
 	"net/url"
@@ -44,6 +47,11 @@ import (
 		return nil
 	}
 
+	getters, err := collectGetters(model.Model.Paths)
+	if err != nil {
+		return err
+	}
+
 	// Iterate over all paths.
 	for pair := orderedmap.SortAlpha(model.Model.Paths.PathItems).First(); pair != nil; pair = pair.Next() {
 		path, pathItems := pair.Key(), pair.Value()
@@ -88,6 +96,18 @@ import (
 				return err
 			}
 			output.Write(m)
+
+			andWait, err := renderAndWait(path, opName, funcName, operation, request, getters)
+			if err != nil {
+				return err
+			}
+			output.Write(andWait)
+
+			seq, err := renderSeq(opName, funcName, operation, request)
+			if err != nil {
+				return err
+			}
+			output.Write(seq)
 		}
 	}
 
@@ -445,19 +465,7 @@ type RequestTmpl struct {
 	// (e.g. list-zones which returns public data and should not trigger IAM enforcement).
 	SkipAuth      bool
 	ErrReturn     string // "nil, " for body-returning ops, "" for no-body (error-only) ops
-	ReturnSection string // pre-rendered final return block injected verbatim into the template
-}
-
-// renderBodyReturnSection returns the final block of a generated operation function
-// that reads and decodes the JSON response body.
-func renderBodyReturnSection(bodyRespType, jsonResponseTarget, funcName string) string {
-	var b strings.Builder
-	b.WriteString("\tbodyresp := " + bodyRespType + "\n")
-	b.WriteString("\tif err := prepareJSONResponse(response, " + jsonResponseTarget + "); err != nil {\n")
-	b.WriteString("\t\treturn nil, fmt.Errorf(\"" + funcName + ": prepare JSON response: %w\", err)\n")
-	b.WriteString("\t}\n\n")
-	b.WriteString("\treturn bodyresp, nil")
-	return b.String()
+	ReturnSection string // pre-rendered final return statement injected verbatim into the template
 }
 
 // serializeRequest serializes the openAPI spec into the request template.
@@ -484,12 +492,12 @@ func serializeRequest(path, httpMethod, funcName string, op *v3.Operation) (*Req
 	p.ValueReturn = fmt.Sprintf("(%s)", strings.Join(valuesReturn, ", "))
 	if p.ValueReturn == "(error)" {
 		p.ErrReturn = ""
-		// response.Body must be closed even for no-content responses (e.g. HTTP 204)
-		// to return the underlying TCP connection to the pool.
-		p.ReturnSection = "\t_ = response.Body.Close()\n\treturn nil"
+		// No response body to decode, Client.do closes it.
+		p.JSONResponseTarget = "nil"
+		p.ReturnSection = "\treturn nil"
 	} else {
 		p.ErrReturn = "nil, "
-		p.ReturnSection = renderBodyReturnSection(p.BodyRespType, p.JSONResponseTarget, funcName)
+		p.ReturnSection = "\treturn bodyresp, nil"
 	}
 	p.URLPathBuilder = renderURLPathBuilder(path, op)
 
@@ -714,3 +722,373 @@ func isArrayReference(sp *base.SchemaProxy) (string, bool) {
 
 	return "", false
 }
+
+const andWaitTemplate = `
+// {{ .Name }}AndWait calls {{ .Name }}, waits for the operation to succeed
+// and returns the {{ .Verb }} resource.
+func (c Client) {{ .Name }}AndWait({{ .Params }}) {{ .ValueReturn }} {
+	op, err := c.{{ .Name }}({{ .Args }})
+	if err != nil {
+		return nil, fmt.Errorf("{{ .Name }}AndWait: %w", err)
+	}
+
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("{{ .Name }}AndWait: %w", &OperationError{ID: op.ID, Err: err})
+	}
+	{{ if .FromReference }}if done.Reference == nil {
+		return nil, fmt.Errorf("{{ .Name }}AndWait: %w", &OperationError{ID: op.ID, Err: ErrMissingOperationReference})
+	}
+	{{ end }}
+	resp, err := c.{{ .GetName }}({{ .GetArgs }})
+	if err != nil {
+		return nil, fmt.Errorf("{{ .Name }}AndWait: %w", &OperationError{ID: op.ID, Err: err})
+	}
+
+	return resp, nil
+}
+`
+
+type AndWait struct {
+	Name          string
+	Verb          string
+	Params        string
+	Args          string
+	ValueReturn   string
+	GetName       string
+	GetArgs       string
+	FromReference bool
+}
+
+// getter represents a GET operation returning a single resource.
+type getter struct {
+	FuncName    string
+	Params      []string
+	ValueReturn []string
+}
+
+// collectGetters returns the GET operations returning a single resource, by path.
+func collectGetters(paths *v3.Paths) (map[string]*getter, error) {
+	getters := make(map[string]*getter)
+
+	for pair := paths.PathItems.First(); pair != nil; pair = pair.Next() {
+		path, pathItem := pair.Key(), pair.Value()
+		op := pathItem.Get
+		if op == nil {
+			continue
+		}
+
+		funcName := helpers.ToCamel(op.OperationId)
+		if !strings.HasPrefix(funcName, "Get") {
+			continue
+		}
+
+		values := getValuesReturn(op, funcName)
+		if len(values) != 2 || !strings.HasPrefix(values[0], "*") {
+			continue
+		}
+
+		getters[path] = &getter{
+			FuncName:    funcName,
+			Params:      getParameters(op, funcName),
+			ValueReturn: values,
+		}
+	}
+
+	return getters, nil
+}
+
+// renderAndWait renders a method creating or updating a resource,
+// waiting for the operation to succeed and returning the resource.
+// It returns a nil output if there is no GET operation to fetch the resource with.
+// The resource created by Create operations (POST on a collection path) is fetched by
+// the operation reference ID (GET on the collection path followed by the resource ID),
+// while the resource updated by Update operations (PUT on a resource path),
+// or created by Create operations on a resource path, is fetched on the same path.
+func renderAndWait(path, httpMethod, funcName string, op *v3.Operation, request *RequestTmpl, getters map[string]*getter) ([]byte, error) {
+	if request.ValueReturn != "(*Operation, error)" {
+		return nil, nil
+	}
+
+	a := AndWait{
+		Name:   funcName,
+		Params: request.Params,
+	}
+
+	var g *getter
+	switch {
+	case httpMethod == "post" && strings.HasPrefix(funcName, "Create"):
+		a.Verb = "created"
+		g = getters[path]
+		if !strings.HasSuffix(path, "}") {
+			g = nil
+			// Sort paths for a deterministic output.
+			var paths []string
+			for p := range getters {
+				paths = append(paths, p)
+			}
+			sort.Strings(paths)
+			for _, p := range paths {
+				last, ok := strings.CutPrefix(p, path+"/{")
+				if ok && strings.HasSuffix(last, "}") && !strings.Contains(last, "/") {
+					g = getters[p]
+					a.FromReference = true
+					break
+				}
+			}
+		}
+	case httpMethod == "put" && strings.HasPrefix(funcName, "Update"):
+		a.Verb = "updated"
+		g = getters[path]
+	}
+	if g == nil {
+		return nil, nil
+	}
+
+	params := getParameters(op, funcName)
+	args := paramNames(params)
+	a.Args = strings.Join(args, ", ")
+
+	// Map the GET path params to the operation ones with the same name and type,
+	// or to the operation reference ID for the last one.
+	getArgs := []string{"ctx"}
+	for i, param := range g.Params[1:] {
+		if strings.HasPrefix(param, "opts ...") {
+			continue
+		}
+
+		if slices.Contains(params, param) {
+			getArgs = append(getArgs, paramNames([]string{param})[0])
+			continue
+		}
+
+		if a.FromReference && i == len(g.Params)-2 && strings.HasSuffix(param, " UUID") {
+			getArgs = append(getArgs, "done.Reference.ID")
+			continue
+		}
+
+		slog.Warn(
+			"no matching parameter to get resource",
+			slog.String("operation", funcName),
+			slog.String("get", g.FuncName),
+			slog.String("param", param),
+		)
+		return nil, nil
+	}
+	a.GetName = g.FuncName
+	a.GetArgs = strings.Join(getArgs, ", ")
+	a.ValueReturn = fmt.Sprintf("(%s)", strings.Join(g.ValueReturn, ", "))
+
+	t, err := template.New("AndWait").Parse(andWaitTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	output := bytes.NewBuffer([]byte{})
+	if err := t.Execute(output, a); err != nil {
+		return nil, err
+	}
+
+	return output.Bytes(), nil
+}
+
+// paramNames returns the names of the given parameters, as call arguments.
+func paramNames(params []string) []string {
+	names := make([]string, 0, len(params))
+	for _, param := range params {
+		name, typ, _ := strings.Cut(param, " ")
+		if strings.HasPrefix(typ, "...") {
+			name += "..."
+		}
+		names = append(names, name)
+	}
+
+	return names
+}
+
+const seqTemplate = `
+// {{ .Name }}Seq returns an iterator over the elements listed by {{ .Name }},
+// decoded while the response is read. The iteration stops at the first error,
+// yielded with a zero element, or once the context is done.
+func (c Client) {{ .Name }}Seq({{ .Params }}) iter.Seq2[{{ .ElemType }}, error] {
+	return func(yield func({{ .ElemType }}, error) bool) {
+		path := {{ .URLPathBuilder }}
+
+		request, err := http.NewRequestWithContext(ctx, "{{ .HTTPMethod }}", c.serverEndpoint + path, nil)
+		if err != nil {
+			yieldError(yield, fmt.Errorf("{{ .Name }}Seq: new request: %w", err))
+			return
+		}
+
+		request.Header.Add("User-Agent", c.getUserAgent())
+
+		{{ if ne .QueryParams nil }}if len(opts) > 0 {
+			q := request.URL.Query()
+			for _, opt := range opts {
+				opt(q)
+			}
+			request.URL.RawQuery = q.Encode()
+		}{{ end }}
+
+		if err := c.executeRequestInterceptors(ctx, request); err != nil {
+			yieldError(yield, fmt.Errorf("{{ .Name }}Seq: execute request editors: %w", err))
+			return
+		}
+
+		bodyresp := {{ .BodyRespType }}
+		call := &Call{OperationID: "{{ .OperationID }}", Request: request, Result: {{ .JSONResponseTarget }}}
+		elems := func() []{{ .ElemType }} { return {{ .Elems }} }
+		if err := streamList(ctx, c, call, {{ not .SkipAuth }}, {{ printf "%q" .Field }}, elems, yield); err != nil {
+			yieldError(yield, fmt.Errorf("{{ .Name }}Seq: %w", err))
+		}
+	}
+}
+{{ if .TimestampField }}
+// {{ .Name }}WindowSeq returns an iterator over the elements listed by {{ .Name }} between from and to,
+// by windows of the given duration in a request each, e.g. to list long periods.
+// The iteration stops at the first error, yielded with a zero element, or once the context is done.
+func (c Client) {{ .Name }}WindowSeq({{ .WindowParams }}) iter.Seq2[{{ .ElemType }}, error] {
+	return windowSeq(ctx, from, to, window,
+		func(v {{ .ElemType }}) time.Time { return v.{{ .TimestampField }} },
+		func(ctx context.Context, from, to time.Time) iter.Seq2[{{ .ElemType }}, error] {
+			windowOpts := append(append([]{{ .Name }}Opt{}, opts...), {{ .Name }}WithFrom(from), {{ .Name }}WithTo(to))
+			return c.{{ .Name }}Seq(ctx, windowOpts...)
+		},
+	)
+}
+{{ end }}`
+
+type Seq struct {
+	*RequestTmpl
+	ElemType       string
+	Field          string
+	Elems          string
+	WindowParams   string
+	TimestampField string
+}
+
+// renderSeq renders iterators over the elements listed by a List operation:
+// the elements of the array returned, or of the only array property of the object returned.
+// For operations filtering elements by time range with from and to query parameters,
+// it also renders an iterator listing the elements by time windows, which requires
+// the elements to have a timestamp property.
+// It returns a nil output for other operations.
+func renderSeq(httpMethod, funcName string, op *v3.Operation, request *RequestTmpl) ([]byte, error) {
+	if httpMethod != "get" || !strings.HasPrefix(funcName, "List") {
+		return nil, nil
+	}
+
+	values := getValuesReturn(op, funcName)
+	if len(values) != 2 {
+		return nil, nil
+	}
+	response, ok := op.Responses.Codes.Get("200")
+	if !ok {
+		return nil, nil
+	}
+	media, ok := response.Content.Get("application/json")
+	if !ok {
+		return nil, nil
+	}
+	sc, err := media.Schema.BuildSchema()
+	if err != nil {
+		return nil, err
+	}
+
+	q := Seq{
+		RequestTmpl: request,
+		Elems:       "bodyresp",
+	}
+
+	var items *base.DynamicValue[*base.SchemaProxy, bool]
+	if strings.HasPrefix(values[0], "[]") {
+		q.ElemType = strings.TrimPrefix(values[0], "[]")
+		items = sc.Items
+	} else {
+		schemas.InferType(sc)
+		if len(sc.Type) == 0 || sc.Type[0] != "object" {
+			return nil, nil
+		}
+
+		var arrays []string
+		for pair := sc.Properties.First(); pair != nil; pair = pair.Next() {
+			prop, err := pair.Value().BuildSchema()
+			if err != nil {
+				return nil, err
+			}
+			schemas.InferType(prop)
+			if len(prop.Type) > 0 && prop.Type[0] == "array" {
+				arrays = append(arrays, pair.Key())
+				items = prop.Items
+			}
+		}
+		if len(arrays) != 1 || items == nil || !items.IsA() {
+			return nil, nil
+		}
+		q.Field = arrays[0]
+		q.Elems = "bodyresp." + helpers.ToCamel(q.Field)
+
+		item, err := items.A.BuildSchema()
+		if err != nil {
+			return nil, err
+		}
+		schemas.InferType(item)
+		switch {
+		case items.A.IsReference():
+			q.ElemType = helpers.RenderReference(items.A.GetReference(), "")
+		case item.AdditionalProperties != nil:
+			return nil, nil
+		case schemas.IsSimpleSchema(item):
+			q.ElemType = schemas.RenderSimpleType(item)
+		default:
+			q.ElemType = strings.TrimPrefix(values[0], "*") + helpers.ToCamel(q.Field)
+		}
+	}
+
+	if hasTimeRangeParams(op) && items != nil && items.IsA() {
+		item, err := items.A.BuildSchema()
+		if err != nil {
+			return nil, err
+		}
+		if ts, ok := item.Properties.Get("timestamp"); ok && ts.Schema() != nil && ts.Schema().Format == "date-time" {
+			q.TimestampField = "Timestamp"
+			params := getParameters(op, funcName)
+			q.WindowParams = strings.Join(slices.Insert(params, len(params)-1, "from, to time.Time", "window time.Duration"), ", ")
+		}
+	}
+
+	t, err := template.New("Seq").Parse(seqTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	output := bytes.NewBuffer([]byte{})
+	if err := t.Execute(output, q); err != nil {
+		return nil, err
+	}
+
+	return output.Bytes(), nil
+}
+
+// hasTimeRangeParams returns true if the operation has from and to date-time query parameters.
+func hasTimeRangeParams(op *v3.Operation) bool {
+	var from, to bool
+	for _, param := range op.Parameters {
+		s := param.Schema.Schema()
+		if param.In != "query" || s == nil || s.Format != "date-time" {
+			continue
+		}
+		switch param.Name {
+		case "from":
+			from = true
+		case "to":
+			to = true
+		}
+	}
+
+	return from && to
+}
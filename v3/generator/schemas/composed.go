@@ -0,0 +1,258 @@
+package schemas
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/exoscale/egoscale/v3/generator/helpers"
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	"github.com/pb33f/libopenapi/orderedmap"
+)
+
+// IsComposedSchema returns true if the schema is composed of sub schemas with oneOf, anyOf or allOf.
+func IsComposedSchema(s *base.Schema) bool {
+	return len(s.OneOf) > 0 || len(s.AnyOf) > 0 || len(s.AllOf) > 0
+}
+
+// singleReference returns the reference of an allOf schema wrapping a single reference,
+// commonly used to document or annotate a property referencing another schema.
+func singleReference(s *base.Schema) (string, bool) {
+	if len(s.OneOf) > 0 || len(s.AnyOf) > 0 || len(s.AllOf) != 1 || orderedmap.Len(s.Properties) > 0 {
+		return "", false
+	}
+	if !s.AllOf[0].IsReference() {
+		return "", false
+	}
+
+	return s.AllOf[0].GetReference(), true
+}
+
+// renderComposed renders a oneOf/anyOf schema as a union type, or an allOf schema as a struct
+// embedding the referenced schemas.
+func renderComposed(typeName string, s *base.Schema, output *bytes.Buffer, schemaName string) (string, error) {
+	if ref, ok := singleReference(s); ok {
+		return "type " + typeName + " " + helpers.RenderReference(ref, schemaName) + "\n", nil
+	}
+
+	if len(s.AllOf) > 0 {
+		return renderAllOf(typeName, s, output, schemaName)
+	}
+
+	return renderUnion(typeName, s, output, schemaName)
+}
+
+// unionVariant represents a variant of a union type.
+type unionVariant struct {
+	field string
+	typ   string
+	// reference is the reference path of the variant schema, if any.
+	reference string
+}
+
+// renderUnion renders a oneOf/anyOf schema as a struct holding a pointer field per variant,
+// implementing json.Marshaler and json.Unmarshaler.
+// With a discriminator, the variant is selected by the value of the discriminator property,
+// otherwise by matching the JSON value against each variant.
+func renderUnion(typeName string, s *base.Schema, output *bytes.Buffer, schemaName string) (string, error) {
+	proxies, exactlyOne := s.OneOf, true
+	if len(proxies) == 0 {
+		proxies, exactlyOne = s.AnyOf, false
+	}
+
+	variants := make([]unionVariant, 0, len(proxies))
+	fields := map[string]int{}
+	for i, p := range proxies {
+		var v unionVariant
+
+		if p.IsReference() {
+			v.reference = p.GetReference()
+			v.typ = helpers.RenderReference(v.reference, schemaName)
+			v.field = v.typ
+		} else {
+			sc, err := p.BuildSchema()
+			if err != nil {
+				return "", fmt.Errorf("%s: variant %d: %w", typeName, i, err)
+			}
+			// A null variant makes the union nullable, which the pointer to the union already is.
+			if len(sc.Type) == 1 && sc.Type[0] == "null" {
+				continue
+			}
+			InferType(sc)
+
+			v.field = "Variant" + strconv.Itoa(i+1)
+			if sc.Title != "" {
+				v.field = helpers.ToCamel(sc.Title)
+			}
+			if IsSimpleSchema(sc) && len(sc.Enum) == 0 && !IsComposedSchema(sc) {
+				v.typ = RenderSimpleType(sc)
+				// Name untitled simple variants after their type, e.g. String or Int.
+				if sc.Title == "" && isAlphanumeric(v.typ) {
+					v.field = helpers.ToCamel(v.typ)
+				}
+			} else {
+				v.typ = typeName + v.field
+				if err := renderSchemaInternal(v.typ, sc, output); err != nil {
+					return "", err
+				}
+			}
+		}
+
+		// Field names must be unique.
+		if n := fields[v.field]; n > 0 {
+			fields[v.field]++
+			v.field += strconv.Itoa(n + 1)
+		} else {
+			fields[v.field] = 1
+		}
+
+		variants = append(variants, v)
+	}
+
+	definition := "type " + typeName + " struct {\n"
+	for _, v := range variants {
+		definition += v.field + " *" + v.typ + "\n"
+	}
+	definition += "}\n\n"
+
+	pointers := make([]string, len(variants))
+	values := make([]string, len(variants))
+	for i, v := range variants {
+		pointers[i] = "&u." + v.field
+		values[i] = "u." + v.field
+	}
+
+	definition += fmt.Sprintf(`// MarshalJSON implements json.Marshaler, encoding the variant set.
+func (u %s) MarshalJSON() ([]byte, error) {
+	return marshalUnion(%s)
+}
+
+`, typeName, strings.Join(values, ", "))
+
+	if s.Discriminator != nil && s.Discriminator.PropertyName != "" {
+		mapping, err := renderDiscriminatorMapping(typeName, s.Discriminator, variants)
+		if err != nil {
+			return "", err
+		}
+
+		definition += fmt.Sprintf(`// UnmarshalJSON implements json.Unmarshaler, decoding the variant selected by the %q property.
+func (u *%s) UnmarshalJSON(data []byte) error {
+	*u = %s{}
+	return unmarshalDiscriminatedUnion(%q, data, %q, map[string]any{
+%s})
+}
+`, s.Discriminator.PropertyName, typeName, typeName, typeName, s.Discriminator.PropertyName, mapping)
+
+		return definition, nil
+	}
+
+	doc := "decoding the variant matching the JSON value."
+	if !exactlyOne {
+		doc = "decoding the variants matching the JSON value."
+	}
+	definition += fmt.Sprintf(`// UnmarshalJSON implements json.Unmarshaler, %s
+func (u *%s) UnmarshalJSON(data []byte) error {
+	*u = %s{}
+	return unmarshalUnion(%q, data, %t, %s)
+}
+`, doc, typeName, typeName, typeName, exactlyOne, strings.Join(pointers, ", "))
+
+	return definition, nil
+}
+
+// renderDiscriminatorMapping renders the map of discriminator values to union fields.
+// Variants not listed in the discriminator mapping are selected by the name of their schema.
+func renderDiscriminatorMapping(typeName string, d *base.Discriminator, variants []unionVariant) (string, error) {
+	mapping := ""
+	for _, v := range variants {
+		if v.reference == "" {
+			return "", fmt.Errorf("%s: discriminated union variants must be references", typeName)
+		}
+
+		var values []string
+		for pair := d.Mapping.First(); pair != nil; pair = pair.Next() {
+			if pair.Value() == v.reference {
+				values = append(values, pair.Key())
+			}
+		}
+		if len(values) == 0 {
+			values = append(values, filepath.Base(v.reference))
+		}
+
+		for _, value := range values {
+			mapping += fmt.Sprintf("%q: &u.%s,\n", value, v.field)
+		}
+	}
+
+	return mapping, nil
+}
+
+// renderAllOf renders an allOf schema as a struct embedding the referenced object schemas,
+// holding the properties of the inline schemas.
+// The properties of oneOf/anyOf parts, inline or referenced, are flattened into the struct as
+// optional properties rather than embedding a union type: the json.Marshaler and json.Unmarshaler
+// of an embedded union would be promoted to the struct, dropping its other fields.
+func renderAllOf(typeName string, s *base.Schema, output *bytes.Buffer, schemaName string) (string, error) {
+	var embedded []string
+
+	merged := &base.Schema{
+		Properties: orderedmap.New[string, *base.SchemaProxy](),
+	}
+
+	// flatten merges the properties of a schema and of its parts into the struct,
+	// optional ones not being required.
+	var flatten func(sc *base.Schema, optional bool) error
+	part := func(i int, p *base.SchemaProxy, optional bool) error {
+		sc, err := p.BuildSchema()
+		if err != nil {
+			return fmt.Errorf("%s: composed schema %d: %w", typeName, i, err)
+		}
+		InferType(sc)
+		if len(sc.Type) > 0 && sc.Type[0] != "object" {
+			return fmt.Errorf("%s: composed schema %d: %s schema cannot be composed into an object", typeName, i, sc.Type[0])
+		}
+
+		if p.IsReference() && !optional && len(sc.OneOf) == 0 && len(sc.AnyOf) == 0 {
+			embedded = append(embedded, helpers.RenderReference(p.GetReference(), schemaName))
+			return nil
+		}
+
+		return flatten(sc, optional)
+	}
+	flatten = func(sc *base.Schema, optional bool) error {
+		for pair := sc.Properties.First(); pair != nil; pair = pair.Next() {
+			merged.Properties.Set(pair.Key(), pair.Value())
+		}
+		if !optional {
+			merged.Required = append(merged.Required, sc.Required...)
+		}
+
+		for i, p := range sc.AllOf {
+			if err := part(i, p, optional); err != nil {
+				return err
+			}
+		}
+		for i, p := range slices.Concat(sc.OneOf, sc.AnyOf) {
+			if err := part(i, p, true); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+	if err := flatten(s, false); err != nil {
+		return "", err
+	}
+
+	object, err := renderObject(typeName, merged, output, schemaName)
+	if err != nil {
+		return "", err
+	}
+
+	// Embed the referenced schemas first.
+	header := "type " + typeName + " struct {\n"
+	return header + strings.Join(append(embedded, ""), "\n") + strings.TrimPrefix(object, header), nil
+}
@@ -0,0 +1,252 @@
+package schemas
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/exoscale/egoscale/v3/generator/helpers"
+	"github.com/pb33f/libopenapi"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"github.com/stretchr/testify/require"
+)
+
+const composedSpec = `
+openapi: 3.0.0
+info:
+  title: test
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    datadog-settings:
+      type: object
+      required: [type]
+      properties:
+        type:
+          type: string
+        api-key:
+          type: string
+    rsyslog-settings:
+      type: object
+      required: [type]
+      properties:
+        type:
+          type: string
+        server:
+          type: string
+    endpoint-settings:
+      description: External endpoint settings
+      oneOf:
+        - $ref: '#/components/schemas/datadog-settings'
+        - $ref: '#/components/schemas/rsyslog-settings'
+      discriminator:
+        propertyName: type
+        mapping:
+          datadog: '#/components/schemas/datadog-settings'
+    port-spec:
+      anyOf:
+        - type: integer
+        - title: range
+          type: object
+          properties:
+            start:
+              type: integer
+            end:
+              type: integer
+    endpoint:
+      allOf:
+        - $ref: '#/components/schemas/datadog-settings'
+        - type: object
+          required: [name]
+          properties:
+            name:
+              type: string
+      properties:
+        id:
+          type: string
+        settings:
+          $ref: '#/components/schemas/endpoint-settings'
+        backup:
+          description: Backup endpoint settings
+          allOf:
+            - $ref: '#/components/schemas/rsyslog-settings'
+        target:
+          oneOf:
+            - type: string
+            - type: integer
+    sink-base:
+      type: object
+      required: [id]
+      properties:
+        id:
+          type: string
+        address:
+          type: string
+          format: ipv4
+        created-at:
+          type: string
+          format: date-time
+    sink:
+      allOf:
+        - $ref: '#/components/schemas/sink-base'
+        - oneOf:
+            - $ref: '#/components/schemas/rsyslog-settings'
+            - type: object
+              required: [url]
+              properties:
+                url:
+                  type: string
+    destination:
+      oneOf:
+        - $ref: '#/components/schemas/datadog-settings'
+        - $ref: '#/components/schemas/rsyslog-settings'
+`
+
+func renderTestSchemas(t *testing.T) map[string]string {
+	t.Helper()
+
+	doc, err := libopenapi.NewDocument([]byte(composedSpec))
+	require.NoError(t, err)
+	model, errs := doc.BuildV3Model()
+	require.Empty(t, errs)
+
+	rendered := map[string]string{}
+	for pair := orderedmap.SortAlpha(model.Model.Components.Schemas).First(); pair != nil; pair = pair.Next() {
+		out, err := RenderSchema(pair.Key(), pair.Value())
+		require.NoError(t, err)
+
+		src, err := format.Source(append([]byte("package test\n\n"), out...))
+		require.NoError(t, err, string(out))
+		rendered[pair.Key()] = string(bytes.TrimPrefix(src, []byte("package test\n\n")))
+	}
+
+	return rendered
+}
+
+func TestRenderComposed(t *testing.T) {
+	rendered := renderTestSchemas(t)
+
+	require.Equal(t, `// External endpoint settings
+type EndpointSettings struct {
+	DatadogSettings *DatadogSettings
+	RsyslogSettings *RsyslogSettings
+}
+
+// MarshalJSON implements json.Marshaler, encoding the variant set.
+func (u EndpointSettings) MarshalJSON() ([]byte, error) {
+	return marshalUnion(u.DatadogSettings, u.RsyslogSettings)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding the variant selected by the "type" property.
+func (u *EndpointSettings) UnmarshalJSON(data []byte) error {
+	*u = EndpointSettings{}
+	return unmarshalDiscriminatedUnion("EndpointSettings", data, "type", map[string]any{
+		"datadog":          &u.DatadogSettings,
+		"rsyslog-settings": &u.RsyslogSettings,
+	})
+}
+`, rendered["endpoint-settings"])
+
+	require.Equal(t, `// range
+type PortSpecRange struct {
+	End   int `+"`json:\"end,omitempty\"`"+`
+	Start int `+"`json:\"start,omitempty\"`"+`
+}
+
+type PortSpec struct {
+	Int   *int
+	Range *PortSpecRange
+}
+
+// MarshalJSON implements json.Marshaler, encoding the variant set.
+func (u PortSpec) MarshalJSON() ([]byte, error) {
+	return marshalUnion(u.Int, u.Range)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding the variants matching the JSON value.
+func (u *PortSpec) UnmarshalJSON(data []byte) error {
+	*u = PortSpec{}
+	return unmarshalUnion("PortSpec", data, false, &u.Int, &u.Range)
+}
+`, rendered["port-spec"])
+
+	// allOf references are embedded and inline schemas properties merged, composed properties rendered.
+	require.Contains(t, rendered["endpoint"], `type EndpointTarget struct {
+	String *string
+	Int    *int
+}
+`)
+	require.Contains(t, rendered["endpoint"], `return unmarshalUnion("EndpointTarget", data, true, &u.String, &u.Int)`)
+	require.Contains(t, rendered["endpoint"], `type Endpoint struct {
+	DatadogSettings
+	// Backup endpoint settings
+	Backup *RsyslogSettings `+"`json:\"backup,omitempty\"`"+`
+	ID     string           `+"`json:\"id,omitempty\"`"+`
+	Name   string           `+"`json:\"name\" validate:\"required\"`"+`
+	// External endpoint settings
+	Settings *EndpointSettings `+"`json:\"settings,omitempty\"`"+`
+	Target   *EndpointTarget   `+"`json:\"target,omitempty\"`"+`
+}
+`)
+}
+
+func TestRenderAllOfComposedParts(t *testing.T) {
+	rendered := renderTestSchemas(t)
+
+	// The properties of the oneOf part are flattened rather than embedding a union type.
+	require.Equal(t, `type Sink struct {
+	SinkBase
+	Server string `+"`json:\"server,omitempty\"`"+`
+	Type   string `+"`json:\"type,omitempty\"`"+`
+	URL    string `+"`json:\"url,omitempty\"`"+`
+}
+`, rendered["sink"])
+	require.NotContains(t, rendered["sink"], "MarshalJSON")
+}
+
+func TestGenerateUnions(t *testing.T) {
+	dir := t.TempDir()
+	generate := func(spec string) {
+		t.Helper()
+		doc, err := libopenapi.NewDocument([]byte(spec))
+		require.NoError(t, err)
+		require.NoError(t, Generate(doc, filepath.Join(dir, "schemas.go"), "test"))
+	}
+
+	generate(composedSpec)
+
+	// The rendered schemas compile along with the union helpers written next to them.
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for _, name := range []string{"schemas.go", "unions.go"} {
+		src, err := os.ReadFile(filepath.Join(dir, name))
+		require.NoError(t, err)
+		// The backwards compatibility aliases refer to schemas of the API spec.
+		for _, alias := range helpers.SpecialAliases {
+			src = bytes.Replace(src, []byte(alias), nil, 1)
+		}
+		f, err := parser.ParseFile(fset, name, src, 0)
+		require.NoError(t, err)
+		require.Equal(t, "test", f.Name.Name)
+		files = append(files, f)
+	}
+	conf := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	_, err := conf.Check("test", fset, files, nil)
+	require.NoError(t, err)
+
+	// The helpers are removed once the schemas no longer use them.
+	generate(composedSpec[:strings.Index(composedSpec, "    endpoint-settings:")])
+	content, err := os.ReadFile(filepath.Join(dir, "schemas.go"))
+	require.NoError(t, err)
+	require.NotContains(t, string(content), "marshalUnion(")
+	require.NoFileExists(t, filepath.Join(dir, "unions.go"))
+}
@@ -6,6 +6,7 @@ import (
 	"go/format"
 	"log/slog"
 	"os"
+	"path/filepath"
 	"regexp"
 	"strings"
 
@@ -75,7 +76,11 @@ import (
 		return fmt.Errorf("format.Source: %v", err)
 	}
 
-	return os.WriteFile(path, content, os.ModePerm)
+	if err := os.WriteFile(path, content, os.ModePerm); err != nil {
+		return err
+	}
+
+	return generateUnions(content, filepath.Dir(path), packageName)
 }
 
 // RenderSchema returns generated go code from an OpenAPI Schema proxy object.
@@ -154,6 +159,17 @@ func RenderSimpleType(s *base.Schema) string {
 // e.g: https://github.com/danielgtaylor/restish/blob/main/openapi/schema.go#L59
 func renderSchemaInternal(schemaName string, s *base.Schema, output *bytes.Buffer) error {
 	doc := renderDoc(s) + "\n"
+
+	if IsComposedSchema(s) {
+		composed, err := renderComposed(schemaName, s, output, schemaName)
+		if err != nil {
+			return err
+		}
+		output.WriteString(doc)
+		output.WriteString(composed)
+		return nil
+	}
+
 	InferType(s)
 
 	// TODO: list type alternatives somehow?
@@ -424,6 +440,21 @@ func renderObject(typeName string, s *base.Schema, output *bytes.Buffer, schemaN
 			continue
 		}
 
+		// A single reference wrapped in allOf is rendered as the reference.
+		if ref, ok := singleReference(prop); ok {
+			definition += camelName + " " + pointer + helpers.RenderReference(ref, schemaName) + tag + "\n"
+			continue
+		}
+
+		// Render new union or allOf type from composed property into the buffer.
+		if IsComposedSchema(prop) {
+			if err := renderSchemaInternal(typeName+camelName, prop, output); err != nil {
+				return "", err
+			}
+			definition += camelName + " " + pointer + typeName + camelName + tag + "\n"
+			continue
+		}
+
 		if propType == "array" {
 			array, err := renderArray(typeName+camelName, prop, output, false, schemaName)
 			if err != nil {
@@ -563,6 +594,10 @@ func InferType(s *base.Schema) {
 
 // IsSimpleSchema returns true if the schema is a scalar type, false otherwise.
 func IsSimpleSchema(s *base.Schema) bool {
+	if IsComposedSchema(s) {
+		return false
+	}
+
 	if len(s.Type) == 0 {
 		return true
 	}
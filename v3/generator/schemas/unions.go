@@ -0,0 +1,39 @@
+package schemas
+
+import (
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/exoscale/egoscale/v3/generator/helpers"
+)
+
+//go:embed unions/unions.go
+var unionsSource []byte
+
+// generateUnions writes the union helpers in the directory of the generated schemas,
+// if they are used by the schemas, and removes them otherwise.
+func generateUnions(schemas []byte, dir, packageName string) error {
+	path := filepath.Join(dir, "unions.go")
+
+	if !bytes.Contains(schemas, []byte("marshalUnion(")) {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		return nil
+	}
+
+	// The package documentation of the helpers is replaced by the generated code header.
+	_, source, ok := bytes.Cut(unionsSource, []byte("package unions\n"))
+	if !ok {
+		return fmt.Errorf("unions: package clause not found")
+	}
+	output := bytes.NewBuffer(helpers.Header(packageName, "v0.0.1"))
+	fmt.Fprintf(output, "package %s\n", packageName)
+	output.Write(source)
+
+	return os.WriteFile(path, output.Bytes(), os.ModePerm)
+}
@@ -0,0 +1,180 @@
+// Package unions holds the helpers of the types rendered for oneOf/anyOf schemas.
+// The generator writes this file into the generated package when the spec has such schemas.
+package unions
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// The generated types of oneOf/anyOf schemas hold a pointer field per variant,
+// at most one of which is set, and rely on these helpers to implement json.Marshaler
+// and json.Unmarshaler.
+
+// marshalUnion returns the JSON encoding of the first non-nil variant, or null.
+func marshalUnion(variants ...any) ([]byte, error) {
+	for _, v := range variants {
+		if rv := reflect.ValueOf(v); rv.IsValid() && !rv.IsNil() {
+			return json.Marshal(v)
+		}
+	}
+
+	return []byte("null"), nil
+}
+
+// unmarshalUnion decodes JSON data into the variants it matches, given as pointers to the
+// pointer fields of the union: a variant matches if the data decode into it and, for objects,
+// hold all its required properties (see requiredFields). Unknown properties are ignored, so that
+// properties added to the API later do not break decoding, unless they tell apart variants
+// otherwise matching a oneOf union: the variant knowing all the properties is then selected.
+// If exactlyOne is true (oneOf), the data must match exactly one variant, otherwise (anyOf) at least one.
+func unmarshalUnion(typeName string, data []byte, exactlyOne bool, variants ...any) error {
+	if isJSONNull(data) {
+		return nil
+	}
+
+	var keys map[string]json.RawMessage
+	_ = json.Unmarshal(data, &keys)
+
+	type match struct {
+		field reflect.Value
+		value reflect.Value
+		known bool
+	}
+	var matches []match
+	for _, variant := range variants {
+		field := reflect.ValueOf(variant).Elem()
+		v := reflect.New(field.Type().Elem())
+
+		if err := json.Unmarshal(data, v.Interface()); err != nil {
+			continue
+		}
+
+		known := true
+		if fields := jsonFields(v.Elem().Type()); fields != nil {
+			if keys == nil {
+				continue
+			}
+			missing := false
+			for name, required := range fields {
+				if _, ok := keys[name]; required && !ok {
+					missing = true
+				}
+			}
+			if missing {
+				continue
+			}
+			for name := range keys {
+				if _, ok := fields[name]; !ok {
+					known = false
+				}
+			}
+		}
+
+		matches = append(matches, match{field: field, value: v, known: known})
+	}
+
+	if exactlyOne && len(matches) > 1 {
+		var known []match
+		for _, m := range matches {
+			if m.known {
+				known = append(known, m)
+			}
+		}
+		if len(known) == 1 {
+			matches = known
+		}
+	}
+
+	if len(matches) == 0 || (exactlyOne && len(matches) > 1) {
+		return fmt.Errorf("%s: JSON value matches %d variants", typeName, len(matches))
+	}
+
+	for _, m := range matches {
+		m.field.Set(m.value)
+	}
+
+	return nil
+}
+
+var unmarshalerType = reflect.TypeFor[json.Unmarshaler]()
+
+// jsonFields returns the JSON properties of a struct type, including those of embedded structs,
+// telling if they are required from their validate tag. It returns nil for other types,
+// and for types decoding themselves such as nested unions.
+func jsonFields(t reflect.Type) map[string]bool {
+	if t.Kind() != reflect.Struct || reflect.PointerTo(t).Implements(unmarshalerType) {
+		return nil
+	}
+
+	fields := map[string]bool{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" || (!f.IsExported() && !f.Anonymous) {
+			continue
+		}
+
+		if f.Anonymous && name == "" {
+			embedded := f.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if sub := jsonFields(embedded); sub != nil {
+				for k, v := range sub {
+					fields[k] = fields[k] || v
+				}
+				continue
+			}
+		}
+
+		if name == "" {
+			name = f.Name
+		}
+		fields[name] = slices.Contains(strings.Split(f.Tag.Get("validate"), ","), "required")
+	}
+
+	return fields
+}
+
+// unmarshalDiscriminatedUnion decodes JSON data into the variant selected by the value of
+// the discriminator property, given the pointers to the pointer fields of the union by discriminator value.
+func unmarshalDiscriminatedUnion(typeName string, data []byte, property string, variants map[string]any) error {
+	if isJSONNull(data) {
+		return nil
+	}
+
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(data, &object); err != nil {
+		return fmt.Errorf("%s: %w", typeName, err)
+	}
+
+	var discriminator string
+	if raw, ok := object[property]; ok {
+		if err := json.Unmarshal(raw, &discriminator); err != nil {
+			return fmt.Errorf("%s: %s: %w", typeName, property, err)
+		}
+	}
+
+	variant, ok := variants[discriminator]
+	if !ok {
+		return fmt.Errorf("%s: unknown %s %q", typeName, property, discriminator)
+	}
+
+	field := reflect.ValueOf(variant).Elem()
+	v := reflect.New(field.Type().Elem())
+	if err := json.Unmarshal(data, v.Interface()); err != nil {
+		return fmt.Errorf("%s: %w", typeName, err)
+	}
+	field.Set(v)
+
+	return nil
+}
+
+func isJSONNull(data []byte) bool {
+	return bytes.Equal(bytes.TrimSpace(data), []byte("null"))
+}
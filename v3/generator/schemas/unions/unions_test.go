@@ -0,0 +1,174 @@
+package unions
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+// Types as rendered by the generator for oneOf/anyOf schemas.
+
+type testDatadogSettings struct {
+	Type   string `json:"type" validate:"required"`
+	APIKey string `json:"api-key,omitempty"`
+}
+
+type testRsyslogSettings struct {
+	Type   string `json:"type" validate:"required"`
+	Server string `json:"server,omitempty"`
+}
+
+type testEndpointSettings struct {
+	DatadogSettings *testDatadogSettings
+	RsyslogSettings *testRsyslogSettings
+}
+
+func (u testEndpointSettings) MarshalJSON() ([]byte, error) {
+	return marshalUnion(u.DatadogSettings, u.RsyslogSettings)
+}
+
+func (u *testEndpointSettings) UnmarshalJSON(data []byte) error {
+	*u = testEndpointSettings{}
+	return unmarshalDiscriminatedUnion("EndpointSettings", data, "type", map[string]any{
+		"datadog": &u.DatadogSettings,
+		"rsyslog": &u.RsyslogSettings,
+	})
+}
+
+type testDestination struct {
+	DatadogSettings *testDatadogSettings
+	RsyslogSettings *testRsyslogSettings
+}
+
+func (u testDestination) MarshalJSON() ([]byte, error) {
+	return marshalUnion(u.DatadogSettings, u.RsyslogSettings)
+}
+
+func (u *testDestination) UnmarshalJSON(data []byte) error {
+	*u = testDestination{}
+	return unmarshalUnion("Destination", data, true, &u.DatadogSettings, &u.RsyslogSettings)
+}
+
+type testTarget struct {
+	String   *string
+	Int      *int
+	Settings *testRsyslogSettings
+}
+
+func (u testTarget) MarshalJSON() ([]byte, error) {
+	return marshalUnion(u.String, u.Int, u.Settings)
+}
+
+func (u *testTarget) UnmarshalJSON(data []byte) error {
+	*u = testTarget{}
+	return unmarshalUnion("Target", data, true, &u.String, &u.Int, &u.Settings)
+}
+
+type testPortRange struct {
+	Start int `json:"start,omitempty"`
+	End   int `json:"end,omitempty"`
+}
+
+type testPortSpec struct {
+	Int   *int
+	Range *testPortRange
+}
+
+func (u testPortSpec) MarshalJSON() ([]byte, error) {
+	return marshalUnion(u.Int, u.Range)
+}
+
+func (u *testPortSpec) UnmarshalJSON(data []byte) error {
+	*u = testPortSpec{}
+	return unmarshalUnion("PortSpec", data, false, &u.Int, &u.Range)
+}
+
+type testEndpoint struct {
+	testDatadogSettings
+	Name     string                `json:"name"`
+	Settings *testEndpointSettings `json:"settings,omitempty"`
+	Target   *testTarget           `json:"target,omitempty"`
+}
+
+func TestUnionJSON(t *testing.T) {
+	port := 514
+	in := testEndpoint{
+		testDatadogSettings: testDatadogSettings{Type: "datadog", APIKey: "key"},
+		Name:                "test",
+		Settings:            &testEndpointSettings{RsyslogSettings: &testRsyslogSettings{Type: "rsyslog", Server: "syslog.example.net"}},
+		Target:              &testTarget{Int: &port},
+	}
+
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"type":"datadog","api-key":"key","name":"test",` +
+		`"settings":{"type":"rsyslog","server":"syslog.example.net"},"target":514}`
+	if string(data) != want {
+		t.Fatalf("expected %s, got %s", want, data)
+	}
+
+	var out testEndpoint
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.APIKey != "key" ||
+		out.Settings.DatadogSettings != nil || out.Settings.RsyslogSettings.Server != "syslog.example.net" ||
+		out.Target.String != nil || *out.Target.Int != 514 {
+		t.Fatalf("unexpected decoded value: %+v", out)
+	}
+}
+
+func TestUnmarshalUnion(t *testing.T) {
+	var target testTarget
+	if err := json.Unmarshal([]byte(`{"type":"rsyslog"}`), &target); err != nil {
+		t.Fatal(err)
+	}
+	if target.Settings == nil || target.Settings.Type != "rsyslog" {
+		t.Fatalf("unexpected decoded value: %+v", target)
+	}
+
+	// Properties added to the API later are ignored.
+	var spec testPortSpec
+	if err := json.Unmarshal([]byte(`{"start":1,"end":2,"step":1}`), &spec); err != nil || spec.Range == nil || spec.Int != nil {
+		t.Fatalf("expected a range, got %+v (%v)", spec, err)
+	}
+	if err := json.Unmarshal([]byte(`22`), &spec); err != nil || spec.Int == nil || *spec.Int != 22 || spec.Range != nil {
+		t.Fatalf("expected a port, got %+v (%v)", spec, err)
+	}
+
+	// Both variants have the required type property: the one knowing all properties is selected.
+	var destination testDestination
+	if err := json.Unmarshal([]byte(`{"type":"rsyslog","server":"syslog"}`), &destination); err != nil ||
+		destination.RsyslogSettings == nil || destination.DatadogSettings != nil {
+		t.Fatalf("expected rsyslog settings, got %+v (%v)", destination, err)
+	}
+
+	// null leaves all variants unset.
+	var settings *testEndpointSettings
+	if err := json.Unmarshal([]byte(`null`), &settings); err != nil || settings != nil {
+		t.Fatalf("expected nil, got %+v (%v)", settings, err)
+	}
+}
+
+func TestUnmarshalUnionErrors(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		data string
+		v    any
+		err  string
+	}{
+		{name: "unknown discriminator", data: `{"type":"splunk"}`, v: &testEndpointSettings{}, err: `unknown type "splunk"`},
+		{name: "no variant", data: `true`, v: &testTarget{}, err: "matches 0 variants"},
+		{name: "missing required property", data: `{"server":"syslog"}`, v: &testDestination{}, err: "matches 0 variants"},
+		{name: "ambiguous", data: `{"type":"rsyslog"}`, v: &testDestination{}, err: "matches 2 variants"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			err := json.Unmarshal([]byte(test.data), test.v)
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Fatalf("expected an error containing %q, got %+v (%v)", test.err, test.v, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package v3
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// redacted replaces sensitive values in log records.
+const redacted = "REDACTED"
+
+// maxLoggedBodySize is the maximum size of the request and response bodies logged.
+// Larger bodies are omitted, since truncated JSON bodies can't be safely redacted.
+const maxLoggedBodySize = 64 << 10
+
+// omitted replaces the bodies larger than maxLoggedBodySize in log records.
+const omitted = "OMITTED"
+
+// sensitiveHeaders lists the HTTP headers never logged in clear text.
+var sensitiveHeaders = []string{
+	"Authorization",
+	"Cookie",
+	"Proxy-Authorization",
+	"Set-Cookie",
+}
+
+// sensitiveFields lists the JSON body fields never logged in clear text:
+// any field whose name contains one of these is redacted.
+var sensitiveFields = []string{
+	"password",
+	"secret",
+	"token",
+	"key",
+	"kubeconfig",
+}
+
+// ClientOptWithLogger returns a ClientOpt logging one record per API operation with the given logger.
+// Records include the operation ID, HTTP method, path, status, latency and retry count.
+// When the logger is enabled for slog.LevelDebug, request and response headers and bodies
+// are logged as well, with credentials and secrets redacted.
+func ClientOptWithLogger(logger *slog.Logger) ClientOpt {
+	return func(c *Client) error {
+		c.logger = logger
+		return nil
+	}
+}
+
+// WithLogger returns a copy of Client with new logger.
+func (c *Client) WithLogger(logger *slog.Logger) *Client {
+	clone := cloneClient(c)
+
+	clone.logger = logger
+
+	return clone
+}
+
+// logOperation logs the outcome of an API operation.
+func (c Client) logOperation(
+	ctx context.Context,
+	operationID string,
+	req *http.Request,
+	resp *http.Response,
+	err error,
+	latency time.Duration,
+	retries int32,
+) {
+	level := slog.LevelInfo
+	attrs := []slog.Attr{
+		slog.String("operation", operationID),
+		slog.String("method", req.Method),
+		slog.String("host", req.URL.Host),
+		slog.String("path", req.URL.Path),
+		slog.Duration("latency", latency),
+		slog.Int("retries", int(retries)),
+	}
+
+	switch {
+	case err != nil:
+		level = slog.LevelError
+		attrs = append(attrs, slog.String("error", err.Error()))
+	case resp.StatusCode >= http.StatusInternalServerError:
+		level = slog.LevelError
+	case resp.StatusCode >= http.StatusBadRequest:
+		level = slog.LevelWarn
+	}
+	if resp != nil {
+		attrs = append(attrs, slog.Int("status", resp.StatusCode))
+	}
+
+	if c.logger.Enabled(ctx, slog.LevelDebug) {
+		var reqBody []byte
+		if req.GetBody != nil {
+			if body, err := req.GetBody(); err == nil {
+				reqBody, _ = io.ReadAll(io.LimitReader(body, maxLoggedBodySize+1))
+				body.Close()
+			}
+		}
+		attrs = append(attrs, slog.Group("request",
+			slog.Any("headers", redactHeaders(req.Header)),
+			slog.String("body", redactBody(reqBody)),
+		))
+
+		if resp != nil {
+			// Only the logged part of the body is read, it is read again when decoded.
+			respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxLoggedBodySize+1))
+			resp.Body = struct {
+				io.Reader
+				io.Closer
+			}{io.MultiReader(bytes.NewReader(respBody), resp.Body), resp.Body}
+			attrs = append(attrs, slog.Group("response",
+				slog.Any("headers", redactHeaders(resp.Header)),
+				slog.String("body", redactBody(respBody)),
+			))
+		}
+	}
+
+	c.logger.LogAttrs(ctx, level, "api operation", attrs...)
+}
+
+// redactHeaders returns a copy of the HTTP headers h with sensitive values redacted.
+func redactHeaders(h http.Header) http.Header {
+	h = h.Clone()
+	for _, name := range sensitiveHeaders {
+		if _, ok := h[name]; ok {
+			h.Set(name, redacted)
+		}
+	}
+
+	return h
+}
+
+// redactBody returns a JSON body with sensitive fields redacted.
+// Non-JSON bodies are not logged, since they can't be safely redacted,
+// nor bodies larger than maxLoggedBodySize.
+func redactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	if len(body) > maxLoggedBodySize {
+		return omitted
+	}
+
+	var v any
+	if err := json.Unmarshal(body, &v); err != nil {
+		return redacted
+	}
+
+	buf, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return redacted
+	}
+
+	return string(buf)
+}
+
+func redactValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			if isSensitiveField(k) {
+				v[k] = redacted
+				continue
+			}
+			v[k] = redactValue(e)
+		}
+	case []any:
+		for i, e := range v {
+			v[i] = redactValue(e)
+		}
+	case string:
+		return redactURL(v)
+	}
+
+	return v
+}
+
+// redactURL returns s with the password redacted if s is a URL holding one,
+// such as the connection URIs of DBaaS services.
+func redactURL(s string) string {
+	if !strings.Contains(s, "://") {
+		return s
+	}
+
+	u, err := url.Parse(s)
+	if err != nil || u.User == nil {
+		return s
+	}
+	if _, ok := u.User.Password(); !ok {
+		return s
+	}
+	u.User = url.UserPassword(u.User.Username(), redacted)
+
+	return u.String()
+}
+
+func isSensitiveField(name string) bool {
+	name = strings.ToLower(name)
+	for _, f := range sensitiveFields {
+		if strings.Contains(name, f) {
+			return true
+		}
+	}
+
+	return false
+}
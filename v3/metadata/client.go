@@ -0,0 +1,237 @@
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrNotFound is returned when the metadata server or the CD-ROM have no value for the requested endpoint.
+var ErrNotFound = errors.New("metadata not found")
+
+// StatusError is returned when the metadata server responds with an unexpected HTTP status.
+type StatusError struct {
+	URL        string
+	StatusCode int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("GET %s: unexpected HTTP status %d", e.URL, e.StatusCode)
+}
+
+// Is reports whether the metadata server responded HTTP 404 when target is ErrNotFound.
+func (e *StatusError) Is(target error) bool {
+	return target == ErrNotFound && e.StatusCode == http.StatusNotFound
+}
+
+const (
+	defaultTimeout     = 5 * time.Second
+	defaultMaxAttempts = 3
+	defaultBackoff     = 500 * time.Millisecond
+)
+
+// Client represents an Exoscale metadata server client.
+type Client struct {
+	url         string
+	httpClient  *http.Client
+	maxAttempts int
+	backoff     time.Duration
+}
+
+// ClientOpt represents a function setting a metadata Client option.
+type ClientOpt func(*Client)
+
+// ClientOptWithURL returns a ClientOpt setting the base URL of the metadata server (URL by default).
+func ClientOptWithURL(u string) ClientOpt {
+	return func(c *Client) {
+		c.url = u
+	}
+}
+
+// ClientOptWithHTTPClient returns a ClientOpt overriding the default http.Client
+// (with a 5 seconds timeout).
+func ClientOptWithHTTPClient(hc *http.Client) ClientOpt {
+	return func(c *Client) {
+		c.httpClient = hc
+	}
+}
+
+// ClientOptWithRetry returns a ClientOpt setting the maximum number of attempts of a request
+// failing with a transport error or HTTP 5xx status (3 by default), and the backoff between attempts
+// (500ms by default, doubled after each attempt).
+func ClientOptWithRetry(maxAttempts int, backoff time.Duration) ClientOpt {
+	return func(c *Client) {
+		c.maxAttempts = max(maxAttempts, 1)
+		c.backoff = backoff
+	}
+}
+
+// NewClient returns a new metadata server client.
+func NewClient(opts ...ClientOpt) *Client {
+	c := &Client{
+		url:         URL,
+		httpClient:  &http.Client{Timeout: defaultTimeout},
+		maxAttempts: defaultMaxAttempts,
+		backoff:     defaultBackoff,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// Get retrieves the value for a specific type of Exoscale metadata.
+func (c *Client) Get(ctx context.Context, endpoint Endpoint) (string, error) {
+	u, err := c.endpointURL(endpoint)
+	if err != nil {
+		return "", err
+	}
+
+	return c.get(ctx, u)
+}
+
+// endpointURL returns the URL of a metadata endpoint.
+func (c *Client) endpointURL(endpoint Endpoint) (string, error) {
+	return url.JoinPath(c.url, "meta-data", string(endpoint))
+}
+
+// UserData retrieves the user-data associated with the current instance.
+func (c *Client) UserData(ctx context.Context) (string, error) {
+	u, err := url.JoinPath(c.url, "user-data")
+	if err != nil {
+		return "", err
+	}
+
+	return c.get(ctx, u)
+}
+
+// Identity represents the identity of the current instance, as exposed by the metadata server.
+// Values not exposed by the metadata server (e.g. the public IPv4 address of a private instance) are zero.
+type Identity struct {
+	AvailabilityZone string
+	CloudIdentifier  string
+	InstanceID       uuid.UUID
+	LocalHostname    string
+	LocalIPv4        netip.Addr
+	PublicHostname   string
+	PublicIPv4       netip.Addr
+	ServiceOffering  string
+	VMID             uuid.UUID
+}
+
+// Identity retrieves and parses all the known metadata of the current instance.
+func (c *Client) Identity(ctx context.Context) (*Identity, error) {
+	identity := &Identity{}
+
+	for _, field := range []struct {
+		endpoint Endpoint
+		parse    func(string) error
+	}{
+		{AvailabilityZone, setString(&identity.AvailabilityZone)},
+		{CloudIdentifier, setString(&identity.CloudIdentifier)},
+		{InstanceID, setUUID(&identity.InstanceID)},
+		{LocalHostname, setString(&identity.LocalHostname)},
+		{LocalIpv4, setAddr(&identity.LocalIPv4)},
+		{PublicHostname, setString(&identity.PublicHostname)},
+		{PublicIpv4, setAddr(&identity.PublicIPv4)},
+		{ServiceOffering, setString(&identity.ServiceOffering)},
+		{VMID, setUUID(&identity.VMID)},
+	} {
+		value, err := c.Get(ctx, field.endpoint)
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("get %s: %w", field.endpoint, err)
+		}
+
+		if value = strings.TrimSpace(value); value == "" {
+			continue
+		}
+		if err := field.parse(value); err != nil {
+			return nil, fmt.Errorf("parse %s: %w", field.endpoint, err)
+		}
+	}
+
+	return identity, nil
+}
+
+func setString(v *string) func(string) error {
+	return func(s string) error {
+		*v = s
+		return nil
+	}
+}
+
+func setUUID(v *uuid.UUID) func(string) error {
+	return func(s string) (err error) {
+		*v, err = uuid.Parse(s)
+		return err
+	}
+}
+
+func setAddr(v *netip.Addr) func(string) error {
+	return func(s string) (err error) {
+		*v, err = netip.ParseAddr(s)
+		return err
+	}
+}
+
+// get sends a GET request to the metadata server, retrying on transport errors and HTTP 5xx statuses.
+func (c *Client) get(ctx context.Context, u string) (string, error) {
+	backoff := c.backoff
+
+	var err error
+	for attempt := 1; ; attempt++ {
+		var body string
+		var retry bool
+		if body, retry, err = c.do(ctx, u); err == nil {
+			return body, nil
+		}
+		if !retry || attempt >= c.maxAttempts {
+			return "", err
+		}
+
+		select {
+		case <-ctx.Done():
+			return "", errors.Join(err, ctx.Err())
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// do sends a GET request to the metadata server, and reports whether it can be retried if it failed.
+func (c *Client) do(ctx context.Context, u string) (string, bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return "", false, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", ctx.Err() == nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", resp.StatusCode >= 500, &StatusError{URL: u, StatusCode: resp.StatusCode}
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", ctx.Err() == nil, err
+	}
+
+	return string(body), false, nil
+}
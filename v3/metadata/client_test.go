@@ -0,0 +1,107 @@
+package metadata
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClient(t *testing.T) {
+	values := map[string]string{
+		"availability-zone": "ch-gva-2",
+		"cloud-identifier":  "CloudStack: 1128bd56b1",
+		"instance-id":       "8f3c2b6e-3d5a-4b8e-9f61-2c7d0e1a4b5c",
+		"local-hostname":    "test",
+		"local-ipv4":        "194.182.160.10",
+		"public-hostname":   "test.example.net",
+		"service-offering":  "standard.medium",
+		"vm-id":             "8f3c2b6e-3d5a-4b8e-9f61-2c7d0e1a4b5c",
+	}
+
+	var failures atomic.Int32
+	failures.Store(2)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Transient server errors are retried.
+		if failures.Add(-1) >= 0 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		if r.URL.Path == "/latest/user-data" {
+			_, _ = w.Write([]byte("#cloud-config\n"))
+			return
+		}
+
+		value, ok := values[strings.TrimPrefix(r.URL.Path, "/latest/meta-data/")]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(value))
+	}))
+	defer ts.Close()
+
+	client := NewClient(
+		ClientOptWithURL(ts.URL+"/latest/"),
+		ClientOptWithRetry(3, time.Millisecond),
+	)
+	ctx := context.Background()
+
+	identity, err := client.Identity(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if identity.AvailabilityZone != "ch-gva-2" ||
+		identity.InstanceID.String() != values["instance-id"] ||
+		identity.LocalIPv4 != netip.MustParseAddr("194.182.160.10") ||
+		identity.ServiceOffering != "standard.medium" {
+		t.Fatalf("unexpected identity: %+v", identity)
+	}
+	// Not exposed by the server.
+	if identity.PublicIPv4.IsValid() {
+		t.Fatalf("expected no public IPv4, got %v", identity.PublicIPv4)
+	}
+
+	userData, err := client.UserData(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if userData != "#cloud-config\n" {
+		t.Fatalf("unexpected user-data: %q", userData)
+	}
+
+	_, err = client.Get(ctx, PublicIpv4)
+	var statusErr *StatusError
+	if !errors.Is(err, ErrNotFound) || !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected %v, got %v", ErrNotFound, err)
+	}
+}
+
+func TestClientRetryExhausted(t *testing.T) {
+	var requests atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ts.Close()
+
+	client := NewClient(
+		ClientOptWithURL(ts.URL+"/latest/"),
+		ClientOptWithRetry(2, time.Millisecond),
+	)
+
+	_, err := client.Get(context.Background(), InstanceID)
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("expected HTTP 500 status error, got %v", err)
+	}
+	if n := requests.Load(); n != 2 {
+		t.Fatalf("expected 2 requests, got %d", n)
+	}
+}
@@ -7,12 +7,7 @@ import (
 	"context"
 	"fmt"
 	"io"
-	"net/http"
-	"net/url"
-	"os"
 	"strings"
-
-	diskfs "github.com/diskfs/go-diskfs"
 )
 
 // Endpoint represents different types of metadata
@@ -21,7 +16,7 @@ type Endpoint string
 
 // These constants define the various types of
 // Exoscale metadata you can retrieve.
-// Use the Get function to access specific metadata.
+// Use the Get function or a Client to access specific metadata.
 const (
 	AvailabilityZone Endpoint = "availability-zone"
 	CloudIdentifier  Endpoint = "cloud-identifier"
@@ -42,21 +37,19 @@ const (
 	CdRomPath = "/dev/disk/by-label/cidata"
 )
 
+// defaultClient is the metadata server client used by the package-level functions.
+var defaultClient = NewClient()
+
 // UserData retrieves the user-data associated with the current instance from the Exoscale server.
 // This data is typically used for Cloudinit/Ignition configuration.
 func UserData(ctx context.Context) (string, error) {
-	return httpGet(ctx, UserDataURL)
+	return defaultClient.UserData(ctx)
 }
 
 // Get retrieves the value for a specific type of Exoscale metadata.
 // Provide the desired Endpoint constant as an argument.
 func Get(ctx context.Context, endpoint Endpoint) (string, error) {
-	url, err := url.JoinPath(MetaDataURL, string(endpoint))
-	if err != nil {
-		return "", err
-	}
-
-	return httpGet(ctx, url)
+	return defaultClient.Get(ctx, endpoint)
 }
 
 // FromCdRom retrieves metadata for Exoscale Private Instance,
@@ -64,48 +57,15 @@ func Get(ctx context.Context, endpoint Endpoint) (string, error) {
 // Important note: Run this code as privileged user.
 // Not Windows compatible.
 func FromCdRom(endpoint Endpoint) (string, error) {
-	disk, err := diskfs.Open(CdRomPath, diskfs.WithOpenMode(diskfs.ReadOnly))
-	if err != nil {
-		return "", fmt.Errorf("disk open: %w", err)
-	}
-	defer disk.File.Close()
-
-	// TODO: Fix the block size in orchestrator from 512 to 2048
-	disk.DefaultBlocks = true
-
-	fs, err := disk.GetFilesystem(0)
-	if err != nil {
-		return "", fmt.Errorf("get filesystem: %w", err)
-	}
-
-	const path = "/meta-data"
-	isoFile, err := fs.OpenFile(path, os.O_RDONLY)
-	if err != nil {
-		return "", fmt.Errorf("open file %s: %w", path, err)
-	}
-	defer isoFile.Close()
-
-	return getFileMetaDataValue(isoFile, string(endpoint))
+	return NewCdRom(CdRomPath).Get(context.Background(), endpoint)
 }
 
-func httpGet(ctx context.Context, url string) (string, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
-	if err != nil {
-		return "", err
-	}
-
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return "", err
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return "", err
-	}
-
-	return string(body), nil
+// UserDataFromCdRom retrieves the user-data of an Exoscale Private Instance,
+// from the attached CD-ROM(iso9660) device file system.
+// Important note: Run this code as privileged user.
+// Not Windows compatible.
+func UserDataFromCdRom() (string, error) {
+	return NewCdRom(CdRomPath).UserData(context.Background())
 }
 
 func getFileMetaDataValue(f io.Reader, endpoint string) (string, error) {
@@ -126,5 +86,5 @@ func getFileMetaDataValue(f io.Reader, endpoint string) (string, error) {
 		return "", fmt.Errorf("get file meta data value scan: %w", err)
 	}
 
-	return "", fmt.Errorf("endpoint '%s' not found in file", endpoint)
+	return "", fmt.Errorf("endpoint %q: %w", endpoint, ErrNotFound)
 }
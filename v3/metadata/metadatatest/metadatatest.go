@@ -0,0 +1,181 @@
+// Package metadatatest provides stand-ins of the Exoscale instance metadata sources,
+// to test guest tooling without running on an Exoscale instance.
+package metadatatest
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+
+	diskfs "github.com/diskfs/go-diskfs"
+	"github.com/diskfs/go-diskfs/disk"
+	"github.com/diskfs/go-diskfs/filesystem"
+	"github.com/diskfs/go-diskfs/filesystem/iso9660"
+
+	"github.com/exoscale/egoscale/v3/metadata"
+)
+
+// Server is a stand-in of the Exoscale metadata server, serving /latest/meta-data/<endpoint>
+// and /latest/user-data over HTTP. Its values can be changed while it is running.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	metaData map[metadata.Endpoint]string
+	userData string
+	status   int
+}
+
+// NewServer starts and returns a new Server serving the given metadata values.
+// The caller should call Close when finished, to shut it down.
+func NewServer(metaData map[metadata.Endpoint]string) *Server {
+	s := &Server{metaData: make(map[metadata.Endpoint]string, len(metaData))}
+	for endpoint, value := range metaData {
+		s.metaData[endpoint] = value
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return s
+}
+
+// BaseURL returns the base URL of the server, to be set with metadata.ClientOptWithURL.
+func (s *Server) BaseURL() string {
+	return s.URL + "/latest/"
+}
+
+// Client returns a metadata.Client querying the server.
+func (s *Server) Client(opts ...metadata.ClientOpt) *metadata.Client {
+	return metadata.NewClient(append([]metadata.ClientOpt{metadata.ClientOptWithURL(s.BaseURL())}, opts...)...)
+}
+
+// Set sets the value of a metadata endpoint.
+func (s *Server) Set(endpoint metadata.Endpoint, value string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.metaData[endpoint] = value
+}
+
+// Delete removes the value of a metadata endpoint, which is then not found (HTTP 404).
+func (s *Server) Delete(endpoint metadata.Endpoint) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.metaData, endpoint)
+}
+
+// SetUserData sets the user-data, not found (HTTP 404) if empty.
+func (s *Server) SetUserData(userData string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.userData = userData
+}
+
+// SetStatus makes the server respond to all requests with the given HTTP status,
+// e.g. to simulate failures. A zero status restores the normal behavior.
+func (s *Server) SetStatus(status int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.status = status
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.status != 0 {
+		w.WriteHeader(s.status)
+		return
+	}
+
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	if r.URL.Path == "/latest/user-data" {
+		if s.userData == "" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(s.userData))
+		return
+	}
+
+	endpoint, ok := strings.CutPrefix(r.URL.Path, "/latest/meta-data/")
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	value, ok := s.metaData[metadata.Endpoint(endpoint)]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	_, _ = w.Write([]byte(value))
+}
+
+// cdRomSize is the size of the image files written by WriteCdRom.
+const cdRomSize = 1024 * 1024
+
+// WriteCdRom writes a cidata iso9660 image file at the given path, holding the given metadata values
+// and user-data like the CD-ROM attached to Exoscale Private Instances, to be read with metadata.NewCdRom.
+// The user-data file is omitted if userData is empty.
+func WriteCdRom(path string, metaData map[metadata.Endpoint]string, userData string) error {
+	d, err := diskfs.Create(path, cdRomSize, diskfs.Raw, diskfs.SectorSizeDefault)
+	if err != nil {
+		return fmt.Errorf("disk create: %w", err)
+	}
+	defer d.File.Close()
+	d.LogicalBlocksize = 2048
+
+	fs, err := d.CreateFilesystem(disk.FilesystemSpec{
+		Partition:   0,
+		FSType:      filesystem.TypeISO9660,
+		VolumeLabel: "cidata",
+	})
+	if err != nil {
+		return fmt.Errorf("create filesystem: %w", err)
+	}
+
+	endpoints := make([]string, 0, len(metaData))
+	for endpoint := range metaData {
+		endpoints = append(endpoints, string(endpoint))
+	}
+	slices.Sort(endpoints)
+
+	var content strings.Builder
+	for _, endpoint := range endpoints {
+		fmt.Fprintf(&content, "%s: %s\n", endpoint, metaData[metadata.Endpoint(endpoint)])
+	}
+
+	files := map[string]string{"/meta-data": content.String()}
+	if userData != "" {
+		files["/user-data"] = userData
+	}
+	for path, content := range files {
+		f, err := fs.OpenFile(path, os.O_CREATE|os.O_RDWR)
+		if err != nil {
+			return fmt.Errorf("open file %s: %w", path, err)
+		}
+		if _, err := f.Write([]byte(content)); err != nil {
+			return fmt.Errorf("write file %s: %w", path, err)
+		}
+	}
+
+	iso, ok := fs.(*iso9660.FileSystem)
+	if !ok {
+		return fmt.Errorf("unexpected filesystem type %T", fs)
+	}
+	if err := iso.Finalize(iso9660.FinalizeOptions{RockRidge: true, VolumeIdentifier: "cidata"}); err != nil {
+		return fmt.Errorf("finalize filesystem: %w", err)
+	}
+
+	return nil
+}
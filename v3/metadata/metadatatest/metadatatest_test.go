@@ -0,0 +1,76 @@
+package metadatatest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/exoscale/egoscale/v3/metadata"
+)
+
+func TestServer(t *testing.T) {
+	server := NewServer(map[metadata.Endpoint]string{metadata.LocalHostname: "test"})
+	defer server.Close()
+
+	client := server.Client(metadata.ClientOptWithRetry(1, 0))
+	ctx := context.Background()
+
+	if hostname, err := client.Get(ctx, metadata.LocalHostname); err != nil || hostname != "test" {
+		t.Fatalf("unexpected hostname %q (error: %v)", hostname, err)
+	}
+	if _, err := client.UserData(ctx); !errors.Is(err, metadata.ErrNotFound) {
+		t.Fatalf("expected %v, got %v", metadata.ErrNotFound, err)
+	}
+
+	server.Set(metadata.LocalHostname, "updated")
+	server.SetUserData("#cloud-config\n")
+	if hostname, err := client.Get(ctx, metadata.LocalHostname); err != nil || hostname != "updated" {
+		t.Fatalf("unexpected hostname %q (error: %v)", hostname, err)
+	}
+	if userData, err := client.UserData(ctx); err != nil || userData != "#cloud-config\n" {
+		t.Fatalf("unexpected user-data %q (error: %v)", userData, err)
+	}
+
+	server.SetStatus(http.StatusServiceUnavailable)
+	var statusErr *metadata.StatusError
+	if _, err := client.Get(ctx, metadata.LocalHostname); !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("expected HTTP 503 status error, got %v", err)
+	}
+	server.SetStatus(0)
+
+	server.Delete(metadata.LocalHostname)
+	if _, err := client.Get(ctx, metadata.LocalHostname); !errors.Is(err, metadata.ErrNotFound) {
+		t.Fatalf("expected %v, got %v", metadata.ErrNotFound, err)
+	}
+}
+
+func TestWriteCdRom(t *testing.T) {
+	values := map[metadata.Endpoint]string{
+		metadata.AvailabilityZone: "ch-gva-2",
+		metadata.InstanceID:       "8f3c2b6e-3d5a-4b8e-9f61-2c7d0e1a4b5c",
+		metadata.LocalHostname:    "private",
+		metadata.ServiceOffering:  "standard.medium",
+	}
+
+	path := filepath.Join(t.TempDir(), "cidata.iso")
+	if err := WriteCdRom(path, values, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	cdrom := metadata.NewCdRom(path)
+	for endpoint, want := range values {
+		got, err := cdrom.Get(context.Background(), endpoint)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Fatalf("%s: expected %q, got %q", endpoint, want, got)
+		}
+	}
+
+	if _, err := cdrom.UserData(context.Background()); !errors.Is(err, metadata.ErrNotFound) {
+		t.Fatalf("expected %v, got %v", metadata.ErrNotFound, err)
+	}
+}
@@ -0,0 +1,230 @@
+package metadata
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"slices"
+	"sync"
+	"time"
+
+	diskfs "github.com/diskfs/go-diskfs"
+)
+
+// Source represents a source of instance metadata and user-data.
+type Source interface {
+	// Get retrieves the value for a specific type of Exoscale metadata.
+	Get(ctx context.Context, endpoint Endpoint) (string, error)
+	// UserData retrieves the user-data associated with the current instance.
+	UserData(ctx context.Context) (string, error)
+}
+
+var (
+	_ Source = (*Client)(nil)
+	_ Source = (*CdRom)(nil)
+	_ Source = (*AutoSource)(nil)
+)
+
+// ErrNoSource is returned when neither the metadata server nor the CD-ROM are available.
+var ErrNoSource = errors.New("no metadata source available")
+
+// CdRom represents the cidata CD-ROM (iso9660) attached to Exoscale Private Instances,
+// exposing the instance metadata and user-data.
+// Important note: reading the CD-ROM device requires a privileged user.
+// Not Windows compatible.
+type CdRom struct {
+	path string
+}
+
+// NewCdRom returns a CdRom reading the iso9660 device or image file at the given path
+// (CdRomPath if empty).
+func NewCdRom(path string) *CdRom {
+	if path == "" {
+		path = CdRomPath
+	}
+
+	return &CdRom{path: path}
+}
+
+// Get retrieves the value for a specific type of Exoscale metadata.
+// It returns an error wrapping ErrNotFound if the CD-ROM holds no value for endpoint.
+func (c *CdRom) Get(_ context.Context, endpoint Endpoint) (string, error) {
+	data, err := c.readFile("/meta-data")
+	if err != nil {
+		return "", err
+	}
+
+	return getFileMetaDataValue(data, string(endpoint))
+}
+
+// UserData retrieves the user-data associated with the current instance.
+// It returns an error wrapping ErrNotFound if the instance has no user-data.
+func (c *CdRom) UserData(_ context.Context) (string, error) {
+	data, err := c.readFile("/user-data")
+	if err != nil {
+		return "", err
+	}
+
+	b, err := io.ReadAll(data)
+	if err != nil {
+		return "", fmt.Errorf("read user-data: %w", err)
+	}
+
+	return string(b), nil
+}
+
+// readFile returns the content of a file of the CD-ROM file system.
+func (c *CdRom) readFile(name string) (io.Reader, error) {
+	disk, err := diskfs.Open(c.path, diskfs.WithOpenMode(diskfs.ReadOnly))
+	if err != nil {
+		return nil, fmt.Errorf("disk open: %w", err)
+	}
+	defer disk.File.Close()
+
+	// TODO: Fix the block size in orchestrator from 512 to 2048
+	disk.DefaultBlocks = true
+
+	fs, err := disk.GetFilesystem(0)
+	if err != nil {
+		return nil, fmt.Errorf("get filesystem: %w", err)
+	}
+
+	// The iso9660 file system has no sentinel error for missing files: look the file up first.
+	entries, err := fs.ReadDir(path.Dir(name))
+	if err != nil {
+		return nil, fmt.Errorf("read dir %s: %w", path.Dir(name), err)
+	}
+	if !slices.ContainsFunc(entries, func(e os.FileInfo) bool { return e.Name() == path.Base(name) }) {
+		return nil, fmt.Errorf("file %s: %w", name, ErrNotFound)
+	}
+
+	isoFile, err := fs.OpenFile(name, os.O_RDONLY)
+	if err != nil {
+		return nil, fmt.Errorf("open file %s: %w", name, err)
+	}
+	defer isoFile.Close()
+
+	data, err := io.ReadAll(isoFile)
+	if err != nil {
+		return nil, fmt.Errorf("read file %s: %w", name, err)
+	}
+
+	return bytes.NewReader(data), nil
+}
+
+// defaultProbeTimeout is the default duration the metadata server is probed for.
+const defaultProbeTimeout = 2 * time.Second
+
+// AutoSource is a Source detecting whether the instance metadata are available
+// from the metadata server, or from the CD-ROM of Exoscale Private Instances.
+// The detected source is cached once found.
+type AutoSource struct {
+	client       *Client
+	cdrom        *CdRom
+	probeTimeout time.Duration
+
+	mu     sync.Mutex
+	source Source
+}
+
+// AutoSourceOpt represents a function setting an AutoSource option.
+type AutoSourceOpt func(*AutoSource)
+
+// AutoSourceOptWithClient returns an AutoSourceOpt setting the metadata server client.
+func AutoSourceOptWithClient(client *Client) AutoSourceOpt {
+	return func(s *AutoSource) {
+		s.client = client
+	}
+}
+
+// AutoSourceOptWithCdRomPath returns an AutoSourceOpt setting the path of the CD-ROM device
+// or image file (CdRomPath by default).
+func AutoSourceOptWithCdRomPath(path string) AutoSourceOpt {
+	return func(s *AutoSource) {
+		s.cdrom = NewCdRom(path)
+	}
+}
+
+// AutoSourceOptWithProbeTimeout returns an AutoSourceOpt setting the duration the metadata server
+// is probed for, before falling back to the CD-ROM (2 seconds by default).
+func AutoSourceOptWithProbeTimeout(d time.Duration) AutoSourceOpt {
+	return func(s *AutoSource) {
+		s.probeTimeout = d
+	}
+}
+
+// NewAutoSource returns a new AutoSource.
+func NewAutoSource(opts ...AutoSourceOpt) *AutoSource {
+	s := &AutoSource{
+		client:       defaultClient,
+		cdrom:        NewCdRom(CdRomPath),
+		probeTimeout: defaultProbeTimeout,
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+// Source returns the detected source, probing the metadata server then the CD-ROM
+// on the first call. It returns an error wrapping ErrNoSource if neither are available,
+// in which case detection is attempted again on the next call.
+func (s *AutoSource) Source(ctx context.Context) (Source, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.source != nil {
+		return s.source, nil
+	}
+
+	probeCtx, cancel := context.WithTimeout(ctx, s.probeTimeout)
+	defer cancel()
+
+	// A single attempt, the server is not expected to fail transiently.
+	u, err := s.client.endpointURL(InstanceID)
+	if err != nil {
+		return nil, err
+	}
+	_, _, httpErr := s.client.do(probeCtx, u)
+	if httpErr == nil {
+		s.source = s.client
+		return s.source, nil
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	_, cdromErr := s.cdrom.Get(ctx, InstanceID)
+	if cdromErr == nil {
+		s.source = s.cdrom
+		return s.source, nil
+	}
+
+	return nil, fmt.Errorf("%w: metadata server: %w, CD-ROM: %w", ErrNoSource, httpErr, cdromErr)
+}
+
+// Get retrieves the value for a specific type of Exoscale metadata from the detected source.
+func (s *AutoSource) Get(ctx context.Context, endpoint Endpoint) (string, error) {
+	source, err := s.Source(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return source.Get(ctx, endpoint)
+}
+
+// UserData retrieves the user-data associated with the current instance from the detected source.
+func (s *AutoSource) UserData(ctx context.Context) (string, error) {
+	source, err := s.Source(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return source.UserData(ctx)
+}
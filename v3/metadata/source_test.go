@@ -0,0 +1,119 @@
+package metadata_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/exoscale/egoscale/v3/metadata"
+	"github.com/exoscale/egoscale/v3/metadata/metadatatest"
+)
+
+func TestAutoSource(t *testing.T) {
+	cdrom := filepath.Join(t.TempDir(), "cidata.iso")
+	if err := metadatatest.WriteCdRom(cdrom, map[metadata.Endpoint]string{
+		metadata.InstanceID:    "8f3c2b6e-3d5a-4b8e-9f61-2c7d0e1a4b5c",
+		metadata.LocalHostname: "private",
+	}, "#cloud-config\n"); err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	t.Run("metadata server", func(t *testing.T) {
+		server := metadatatest.NewServer(map[metadata.Endpoint]string{
+			metadata.InstanceID:    "8f3c2b6e-3d5a-4b8e-9f61-2c7d0e1a4b5c",
+			metadata.LocalHostname: "public",
+		})
+		defer server.Close()
+
+		source := metadata.NewAutoSource(
+			metadata.AutoSourceOptWithClient(server.Client()),
+			metadata.AutoSourceOptWithCdRomPath(cdrom),
+		)
+
+		hostname, err := source.Get(ctx, metadata.LocalHostname)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hostname != "public" {
+			t.Fatalf("expected hostname from the metadata server, got %q", hostname)
+		}
+	})
+
+	t.Run("CD-ROM fallback", func(t *testing.T) {
+		ts := httptest.NewServer(http.NotFoundHandler())
+		ts.Close()
+
+		source := metadata.NewAutoSource(
+			metadata.AutoSourceOptWithClient(metadata.NewClient(metadata.ClientOptWithURL(ts.URL+"/latest/"))),
+			metadata.AutoSourceOptWithCdRomPath(cdrom),
+			metadata.AutoSourceOptWithProbeTimeout(time.Second),
+		)
+
+		hostname, err := source.Get(ctx, metadata.LocalHostname)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hostname != "private" {
+			t.Fatalf("expected hostname from the CD-ROM, got %q", hostname)
+		}
+
+		userData, err := source.UserData(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if userData != "#cloud-config\n" {
+			t.Fatalf("unexpected user-data: %q", userData)
+		}
+
+		// The detected source is cached.
+		detected, err := source.Source(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := detected.(*metadata.CdRom); !ok {
+			t.Fatalf("expected the CD-ROM source, got %T", detected)
+		}
+	})
+
+	t.Run("no source", func(t *testing.T) {
+		ts := httptest.NewServer(http.NotFoundHandler())
+		ts.Close()
+
+		source := metadata.NewAutoSource(
+			metadata.AutoSourceOptWithClient(metadata.NewClient(metadata.ClientOptWithURL(ts.URL+"/latest/"))),
+			metadata.AutoSourceOptWithCdRomPath(filepath.Join(t.TempDir(), "missing.iso")),
+		)
+
+		if _, err := source.Get(ctx, metadata.LocalHostname); !errors.Is(err, metadata.ErrNoSource) {
+			t.Fatalf("expected %v, got %v", metadata.ErrNoSource, err)
+		}
+	})
+}
+
+func TestCdRomNotFound(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cidata.iso")
+	if err := metadatatest.WriteCdRom(path, map[metadata.Endpoint]string{
+		metadata.InstanceID: "8f3c2b6e-3d5a-4b8e-9f61-2c7d0e1a4b5c",
+	}, ""); err != nil {
+		t.Fatal(err)
+	}
+	cdrom := metadata.NewCdRom(path)
+	ctx := context.Background()
+
+	if _, err := cdrom.Get(ctx, metadata.PublicIpv4); !errors.Is(err, metadata.ErrNotFound) {
+		t.Fatalf("expected %v, got %v", metadata.ErrNotFound, err)
+	}
+	if _, err := cdrom.UserData(ctx); !errors.Is(err, metadata.ErrNotFound) {
+		t.Fatalf("expected %v, got %v", metadata.ErrNotFound, err)
+	}
+
+	// A missing CD-ROM is not a missing value.
+	if _, err := metadata.NewCdRom(filepath.Join(t.TempDir(), "missing.iso")).Get(ctx, metadata.InstanceID); err == nil || errors.Is(err, metadata.ErrNotFound) {
+		t.Fatalf("expected an error reading a missing CD-ROM, got %v", err)
+	}
+}
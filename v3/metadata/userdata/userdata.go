@@ -0,0 +1,284 @@
+// Package userdata decodes and encodes instance user-data: cloud-init configuration (cloud-config YAML,
+// scripts, multipart MIME archives) and Ignition configuration, optionally gzip-compressed.
+package userdata
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// MaxSize is the maximum size of base64-encoded user-data accepted by the Exoscale API.
+const MaxSize = 32768
+
+// ErrTooLarge is returned when encoded user-data exceed MaxSize.
+var ErrTooLarge = errors.New("user-data too large")
+
+// Format represents a user-data format.
+type Format string
+
+const (
+	FormatUnknown     Format = "unknown"
+	FormatCloudConfig Format = "cloud-config"
+	FormatScript      Format = "script"
+	FormatMultipart   Format = "multipart"
+	FormatIgnition    Format = "ignition"
+)
+
+const cloudConfigHeader = "#cloud-config"
+
+// UserData represents decoded user-data.
+type UserData struct {
+	// Format is the detected format of the user-data.
+	Format Format
+	// Compressed reports whether the user-data were gzip-compressed.
+	Compressed bool
+	// Content is the decompressed user-data.
+	Content []byte
+	// Parts holds the parts of multipart user-data.
+	Parts []Part
+}
+
+// Part represents a part of multipart user-data.
+type Part struct {
+	// ContentType is the MIME type of the part, e.g. text/cloud-config or text/x-shellscript.
+	ContentType string
+	// Filename is the file name of the part, if any.
+	Filename string
+	// Content is the decoded content of the part.
+	Content []byte
+}
+
+// Decode decodes raw user-data, as returned by the metadata server, detecting their format.
+func Decode(data []byte) (*UserData, error) {
+	ud := &UserData{Format: FormatUnknown, Content: data}
+
+	if isGzip(data) {
+		r, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("gzip: %w", err)
+		}
+		if ud.Content, err = io.ReadAll(r); err != nil {
+			return nil, fmt.Errorf("gzip: %w", err)
+		}
+		ud.Compressed = true
+	}
+
+	content := bytes.TrimLeft(ud.Content, " \t\r\n")
+	switch {
+	case bytes.HasPrefix(content, []byte(cloudConfigHeader)):
+		ud.Format = FormatCloudConfig
+	case bytes.HasPrefix(content, []byte("#!")):
+		ud.Format = FormatScript
+	case bytes.HasPrefix(content, []byte("{")):
+		var config struct {
+			Ignition *struct {
+				Version string `json:"version"`
+			} `json:"ignition"`
+		}
+		if err := json.Unmarshal(content, &config); err == nil && config.Ignition != nil {
+			ud.Format = FormatIgnition
+		}
+	case isMultipart(content):
+		parts, err := decodeMultipart(content)
+		if err != nil {
+			return nil, fmt.Errorf("multipart: %w", err)
+		}
+		ud.Format = FormatMultipart
+		ud.Parts = parts
+	}
+
+	return ud, nil
+}
+
+// DecodeBase64 decodes base64-encoded user-data, as returned by the Exoscale API.
+func DecodeBase64(s string) (*UserData, error) {
+	data, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("base64: %w", err)
+	}
+
+	return Decode(data)
+}
+
+// CloudConfig decodes cloud-config user-data into v, as yaml.Unmarshal does.
+func (u *UserData) CloudConfig(v any) error {
+	if u.Format != FormatCloudConfig {
+		return fmt.Errorf("user-data format is %s, not %s", u.Format, FormatCloudConfig)
+	}
+
+	return yaml.Unmarshal(u.Content, v)
+}
+
+// Ignition decodes Ignition user-data into v, as json.Unmarshal does.
+func (u *UserData) Ignition(v any) error {
+	if u.Format != FormatIgnition {
+		return fmt.Errorf("user-data format is %s, not %s", u.Format, FormatIgnition)
+	}
+
+	return json.Unmarshal(u.Content, v)
+}
+
+// EncodeCloudConfig returns cloud-config user-data holding v marshaled as YAML.
+func EncodeCloudConfig(v any) ([]byte, error) {
+	data, err := yaml.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(cloudConfigHeader+"\n"), data...), nil
+}
+
+// EncodeMultipart returns multipart MIME user-data holding the given parts.
+func EncodeMultipart(parts ...Part) ([]byte, error) {
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+
+	for _, part := range parts {
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", part.ContentType)
+		header.Set("MIME-Version", "1.0")
+		header.Set("Content-Transfer-Encoding", "base64")
+		if part.Filename != "" {
+			header.Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": part.Filename}))
+		}
+
+		pw, err := w.CreatePart(header)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := pw.Write([]byte(base64.StdEncoding.EncodeToString(part.Content))); err != nil {
+			return nil, err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "Content-Type: %s\r\n", mime.FormatMediaType("multipart/mixed", map[string]string{"boundary": w.Boundary()}))
+	fmt.Fprintf(&msg, "MIME-Version: 1.0\r\n\r\n")
+	msg.Write(body.Bytes())
+
+	return msg.Bytes(), nil
+}
+
+// EncodeOpt represents an Encode option.
+type EncodeOpt func(*encodeOptions)
+
+type encodeOptions struct {
+	compress bool
+}
+
+// EncodeOptWithCompression returns an EncodeOpt gzip-compressing the user-data
+// (supported by cloud-init).
+func EncodeOptWithCompression() EncodeOpt {
+	return func(o *encodeOptions) {
+		o.compress = true
+	}
+}
+
+// Encode returns base64-encoded user-data, suitable for the UserData field of the CreateInstanceRequest,
+// UpdateInstanceRequest and CreateInstancePoolRequest API requests.
+// It returns an error wrapping ErrTooLarge if the encoded user-data exceed MaxSize.
+func Encode(data []byte, opts ...EncodeOpt) (string, error) {
+	var o encodeOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	if o.compress && !isGzip(data) {
+		var buf bytes.Buffer
+		w, err := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+		if err != nil {
+			return "", err
+		}
+		if _, err := w.Write(data); err != nil {
+			return "", fmt.Errorf("gzip: %w", err)
+		}
+		if err := w.Close(); err != nil {
+			return "", fmt.Errorf("gzip: %w", err)
+		}
+		data = buf.Bytes()
+	}
+
+	encoded := base64.StdEncoding.EncodeToString(data)
+	if len(encoded) > MaxSize {
+		return "", fmt.Errorf("%w: %d bytes encoded, maximum is %d", ErrTooLarge, len(encoded), MaxSize)
+	}
+
+	return encoded, nil
+}
+
+func isGzip(data []byte) bool {
+	return len(data) >= 2 && data[0] == 0x1f && data[1] == 0x8b
+}
+
+// isMultipart reports whether the data start with MIME headers declaring a multipart content type.
+func isMultipart(data []byte) bool {
+	r := textproto.NewReader(bufio.NewReader(bytes.NewReader(data)))
+	header, err := r.ReadMIMEHeader()
+	if err != nil {
+		return false
+	}
+
+	mediaType, _, err := mime.ParseMediaType(header.Get("Content-Type"))
+
+	return err == nil && strings.HasPrefix(mediaType, "multipart/")
+}
+
+func decodeMultipart(data []byte) ([]Part, error) {
+	msg, err := mail.ReadMessage(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	_, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil {
+		return nil, err
+	}
+
+	var parts []Part
+	r := multipart.NewReader(msg.Body, params["boundary"])
+	for {
+		p, err := r.NextRawPart()
+		if errors.Is(err, io.EOF) {
+			return parts, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var body io.Reader = p
+		switch strings.ToLower(p.Header.Get("Content-Transfer-Encoding")) {
+		case "base64":
+			body = base64.NewDecoder(base64.StdEncoding, p)
+		case "quoted-printable":
+			body = quotedprintable.NewReader(p)
+		}
+		content, err := io.ReadAll(body)
+		if err != nil {
+			return nil, err
+		}
+
+		contentType, _, _ := mime.ParseMediaType(p.Header.Get("Content-Type"))
+		parts = append(parts, Part{
+			ContentType: contentType,
+			Filename:    p.FileName(),
+			Content:     content,
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package userdata
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/rand"
+	"errors"
+	"testing"
+)
+
+func TestDecode(t *testing.T) {
+	var gzipped bytes.Buffer
+	w := gzip.NewWriter(&gzipped)
+	_, _ = w.Write([]byte("#cloud-config\npackages: [nginx]\n"))
+	_ = w.Close()
+
+	for _, test := range []struct {
+		name       string
+		data       []byte
+		format     Format
+		compressed bool
+	}{
+		{name: "cloud-config", data: []byte("#cloud-config\npackages: [nginx]\n"), format: FormatCloudConfig},
+		{name: "gzip", data: gzipped.Bytes(), format: FormatCloudConfig, compressed: true},
+		{name: "script", data: []byte("#!/bin/sh\necho hello\n"), format: FormatScript},
+		{name: "ignition", data: []byte(`{"ignition":{"version":"3.4.0"}}`), format: FormatIgnition},
+		{name: "JSON", data: []byte(`{"name":"test"}`), format: FormatUnknown},
+		{name: "unknown", data: []byte("hello"), format: FormatUnknown},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			ud, err := Decode(test.data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ud.Format != test.format || ud.Compressed != test.compressed {
+				t.Fatalf("expected %s (compressed: %v), got %s (compressed: %v)",
+					test.format, test.compressed, ud.Format, ud.Compressed)
+			}
+		})
+	}
+}
+
+func TestCloudConfig(t *testing.T) {
+	data, err := EncodeCloudConfig(map[string]any{"packages": []string{"nginx"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encoded, err := Encode(data, EncodeOptWithCompression())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ud, err := DecodeBase64(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ud.Compressed {
+		t.Fatal("expected compressed user-data")
+	}
+
+	var config struct {
+		Packages []string `yaml:"packages"`
+	}
+	if err := ud.CloudConfig(&config); err != nil {
+		t.Fatal(err)
+	}
+	if len(config.Packages) != 1 || config.Packages[0] != "nginx" {
+		t.Fatalf("unexpected cloud-config: %+v", config)
+	}
+
+	if err := ud.Ignition(&config); err == nil {
+		t.Fatal("expected an error decoding cloud-config as Ignition")
+	}
+}
+
+func TestMultipart(t *testing.T) {
+	parts := []Part{
+		{ContentType: "text/cloud-config", Filename: "config.yaml", Content: []byte("#cloud-config\npackages: [nginx]\n")},
+		{ContentType: "text/x-shellscript", Filename: "setup.sh", Content: []byte("#!/bin/sh\necho hello\n")},
+	}
+
+	data, err := EncodeMultipart(parts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ud, err := Decode(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ud.Format != FormatMultipart {
+		t.Fatalf("expected %s, got %s", FormatMultipart, ud.Format)
+	}
+	if len(ud.Parts) != len(parts) {
+		t.Fatalf("expected %d parts, got %d", len(parts), len(ud.Parts))
+	}
+	for i, part := range ud.Parts {
+		if part.ContentType != parts[i].ContentType ||
+			part.Filename != parts[i].Filename ||
+			!bytes.Equal(part.Content, parts[i].Content) {
+			t.Fatalf("expected part %+v, got %+v", parts[i], part)
+		}
+	}
+}
+
+func TestEncodeTooLarge(t *testing.T) {
+	// Random data does not compress.
+	data := make([]byte, MaxSize)
+	_, _ = rand.Read(data)
+
+	for _, opts := range [][]EncodeOpt{nil, {EncodeOptWithCompression()}} {
+		if _, err := Encode(data, opts...); !errors.Is(err, ErrTooLarge) {
+			t.Fatalf("expected %v, got %v", ErrTooLarge, err)
+		}
+	}
+
+	// Compression makes repetitive data fit.
+	if _, err := Encode(bytes.Repeat([]byte("a"), MaxSize), EncodeOptWithCompression()); err != nil {
+		t.Fatal(err)
+	}
+}
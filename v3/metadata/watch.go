@@ -0,0 +1,146 @@
+package metadata
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+const (
+	defaultWatchInterval   = 30 * time.Second
+	defaultWatchMaxBackoff = 5 * time.Minute
+)
+
+// Event represents a change of an instance metadata value, or a failure to retrieve it.
+type Event struct {
+	// Endpoint is the type of metadata.
+	Endpoint Endpoint
+	// Previous is the previous value, empty for the first value retrieved.
+	Previous string
+	// Value is the new value, empty if the source has no value for the endpoint.
+	Value string
+	// Initial reports whether Value is the first value retrieved.
+	Initial bool
+	// Err is the error retrieving the value, if any. Previous and Value are empty then.
+	Err error
+}
+
+// Watcher polls instance metadata values, emitting an Event when they change.
+type Watcher struct {
+	source     Source
+	interval   time.Duration
+	maxBackoff time.Duration
+}
+
+// WatcherOpt represents a function setting a Watcher option.
+type WatcherOpt func(*Watcher)
+
+// WatcherOptWithInterval returns a WatcherOpt setting the polling interval (30 seconds by default).
+func WatcherOptWithInterval(d time.Duration) WatcherOpt {
+	return func(w *Watcher) {
+		w.interval = d
+	}
+}
+
+// WatcherOptWithMaxBackoff returns a WatcherOpt setting the maximum polling interval:
+// the interval is doubled after each polling failure, up to this value (5 minutes by default).
+func WatcherOptWithMaxBackoff(d time.Duration) WatcherOpt {
+	return func(w *Watcher) {
+		w.maxBackoff = d
+	}
+}
+
+// NewWatcher returns a Watcher polling the given source.
+func NewWatcher(source Source, opts ...WatcherOpt) *Watcher {
+	w := &Watcher{
+		source:     source,
+		interval:   defaultWatchInterval,
+		maxBackoff: defaultWatchMaxBackoff,
+	}
+
+	for _, opt := range opts {
+		opt(w)
+	}
+
+	return w
+}
+
+// Watch polls the metadata server for the given endpoints with the default Watcher,
+// see Watcher.Watch.
+func Watch(ctx context.Context, endpoints ...Endpoint) <-chan Event {
+	return NewWatcher(defaultClient).Watch(ctx, endpoints...)
+}
+
+// Watch polls the values of the given endpoints until the context is done, and returns a channel
+// receiving an Event for the first value of each endpoint, then for each value change or polling failure.
+// The channel is closed once the context is done.
+func (w *Watcher) Watch(ctx context.Context, endpoints ...Endpoint) <-chan Event {
+	events := make(chan Event)
+
+	go func() {
+		defer close(events)
+
+		values := make(map[Endpoint]string, len(endpoints))
+		interval := w.interval
+		for {
+			failed := false
+			for _, endpoint := range endpoints {
+				event, changed := w.poll(ctx, endpoint, values)
+				if ctx.Err() != nil {
+					return
+				}
+				if event.Err != nil {
+					failed = true
+				}
+				if !changed {
+					continue
+				}
+
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			if failed {
+				interval = min(interval*2, max(w.maxBackoff, w.interval))
+			} else {
+				interval = w.interval
+			}
+
+			select {
+			case <-time.After(interval):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events
+}
+
+// poll retrieves the value of an endpoint, and returns the resulting event and whether it should be emitted.
+func (w *Watcher) poll(ctx context.Context, endpoint Endpoint, values map[Endpoint]string) (Event, bool) {
+	value, err := w.source.Get(ctx, endpoint)
+	// A missing value (HTTP 404 or absent from the CD-ROM) is an empty value, not a polling failure.
+	if errors.Is(err, ErrNotFound) {
+		value, err = "", nil
+	}
+	if err != nil {
+		return Event{Endpoint: endpoint, Err: err}, true
+	}
+
+	previous, ok := values[endpoint]
+	values[endpoint] = value
+	if ok && value == previous {
+		return Event{}, false
+	}
+
+	return Event{
+		Endpoint: endpoint,
+		Previous: previous,
+		Value:    value,
+		Initial:  !ok,
+	}, true
+}
@@ -0,0 +1,115 @@
+package metadata_test
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/exoscale/egoscale/v3/metadata"
+	"github.com/exoscale/egoscale/v3/metadata/metadatatest"
+)
+
+func TestWatch(t *testing.T) {
+	server := metadatatest.NewServer(map[metadata.Endpoint]string{metadata.LocalHostname: "test"})
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	events := metadata.NewWatcher(server.Client(metadata.ClientOptWithRetry(1, 0)),
+		metadata.WatcherOptWithInterval(5*time.Millisecond),
+		metadata.WatcherOptWithMaxBackoff(20*time.Millisecond),
+	).Watch(ctx, metadata.LocalHostname, metadata.PublicIpv4)
+
+	next := func() metadata.Event {
+		t.Helper()
+		select {
+		case event := <-events:
+			return event
+		case <-ctx.Done():
+			t.Fatal("timeout waiting for event")
+		}
+		return metadata.Event{}
+	}
+
+	// Initial values.
+	for _, want := range []metadata.Event{
+		{Endpoint: metadata.LocalHostname, Value: "test", Initial: true},
+		{Endpoint: metadata.PublicIpv4, Initial: true},
+	} {
+		if event := next(); event != want {
+			t.Fatalf("expected %+v, got %+v", want, event)
+		}
+	}
+
+	server.Set(metadata.PublicIpv4, "194.182.160.10")
+	want := metadata.Event{Endpoint: metadata.PublicIpv4, Value: "194.182.160.10"}
+	if event := next(); event != want {
+		t.Fatalf("expected %+v, got %+v", want, event)
+	}
+
+	server.SetStatus(http.StatusInternalServerError)
+	if event := next(); event.Err == nil {
+		t.Fatalf("expected a polling error, got %+v", event)
+	}
+	// Drain the polling errors until the server is back.
+	server.Set(metadata.LocalHostname, "renamed")
+	server.SetStatus(0)
+	for {
+		event := next()
+		if event.Err != nil {
+			continue
+		}
+		want := metadata.Event{Endpoint: metadata.LocalHostname, Previous: "test", Value: "renamed"}
+		if event != want {
+			t.Fatalf("expected %+v, got %+v", want, event)
+		}
+		break
+	}
+
+	cancel()
+	for range events {
+	}
+}
+
+func TestWatchCdRom(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cidata.iso")
+	if err := metadatatest.WriteCdRom(path, map[metadata.Endpoint]string{metadata.LocalHostname: "private"}, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	events := metadata.NewWatcher(metadata.NewCdRom(path),
+		metadata.WatcherOptWithInterval(5*time.Millisecond),
+	).Watch(ctx, metadata.LocalHostname, metadata.PublicIpv4)
+
+	// The value missing from the CD-ROM is empty, not a polling error.
+	for _, want := range []metadata.Event{
+		{Endpoint: metadata.LocalHostname, Value: "private", Initial: true},
+		{Endpoint: metadata.PublicIpv4, Initial: true},
+	} {
+		select {
+		case event := <-events:
+			if event != want {
+				t.Fatalf("expected %+v, got %+v", want, event)
+			}
+		case <-ctx.Done():
+			t.Fatal("timeout waiting for event")
+		}
+	}
+
+	// Unchanged values are not emitted again.
+	select {
+	case event := <-events:
+		t.Fatalf("unexpected event %+v", event)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	cancel()
+	for range events {
+	}
+}
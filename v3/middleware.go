@@ -0,0 +1,79 @@
+package v3
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// Call represents an API operation call going through the middleware chain.
+type Call struct {
+	// OperationID is the ID of the API operation (e.g. "list-instances").
+	OperationID string
+	// Request is the HTTP request of the operation.
+	// It is signed by the innermost handler, after all middlewares ran.
+	Request *http.Request
+	// Response is the HTTP response of the operation, set once the request is sent.
+	// Its body is already consumed when the handler returns.
+	Response *http.Response
+	// Result is the value the response body is decoded into (e.g. *ListInstancesResponse),
+	// or nil for operations without response body.
+	// It is populated once the handler returns without error, unless the call is streamed.
+	Result any
+	// Stream, if not nil, decodes the response body while it is read instead of decoding it
+	// into Result, which is left zero: it is set by the ListXSeq iterators, yielding the listed
+	// elements as they are decoded. The iteration may stop before the whole body is decoded.
+	// A middleware serving a result without calling the next handler can populate Result
+	// regardless: its elements are then yielded instead.
+	Stream func(dec *json.Decoder) error
+}
+
+// Handler executes an API operation call.
+type Handler func(ctx context.Context, call *Call) error
+
+// Middleware wraps a Handler to observe or alter API operation calls:
+// it can modify the request before calling the next handler, inspect the
+// response, decoded result and error after, or return without calling
+// the next handler at all (e.g. to serve a result from a cache).
+type Middleware func(next Handler) Handler
+
+// ClientOptWithMiddlewares returns a ClientOpt wrapping every API operation with the given middlewares.
+// The first middleware is the outermost one.
+func ClientOptWithMiddlewares(m ...Middleware) ClientOpt {
+	return func(c *Client) error {
+		c.middlewares = append(c.middlewares, m...)
+		return nil
+	}
+}
+
+// WithMiddleware returns a copy of Client with new middlewares.
+func (c *Client) WithMiddleware(m ...Middleware) *Client {
+	clone := cloneClient(c)
+
+	clone.middlewares = append(append([]Middleware{}, clone.middlewares...), m...)
+
+	return clone
+}
+
+// do executes an API operation call through the middleware chain,
+// decoding the response body into result if not nil.
+func (c Client) do(ctx context.Context, operationID string, req *http.Request, sign bool, result any) error {
+	return c.handle(ctx, &Call{
+		OperationID: operationID,
+		Request:     req,
+		Result:      result,
+	}, sign)
+}
+
+// handle executes an API operation call through the middleware chain.
+func (c Client) handle(ctx context.Context, call *Call, sign bool) error {
+	handler := c.send(sign)
+	if c.circuitBreaker != nil {
+		handler = c.circuitBreaker.middleware(handler)
+	}
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		handler = c.middlewares[i](handler)
+	}
+
+	return handler(ctx, call)
+}
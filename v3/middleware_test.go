@@ -0,0 +1,97 @@
+package v3
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"sync/atomic"
+	"testing"
+)
+
+func TestClientOptWithMiddlewares(t *testing.T) {
+	var order []string
+	audit := func(next Handler) Handler {
+		return func(ctx context.Context, call *Call) error {
+			order = append(order, "audit")
+			call.Request.Header.Set("X-Audit", "test")
+			return next(ctx, call)
+		}
+	}
+
+	cache := map[string]*ListZonesResponse{}
+	caching := func(next Handler) Handler {
+		return func(ctx context.Context, call *Call) error {
+			order = append(order, "cache")
+			if cached, ok := cache[call.OperationID]; ok {
+				*call.Result.(*ListZonesResponse) = *cached
+				return nil
+			}
+
+			if err := next(ctx, call); err != nil {
+				return err
+			}
+			if call.Response.StatusCode != http.StatusOK {
+				t.Errorf("expected response status to be visible, got %d", call.Response.StatusCode)
+			}
+			cache[call.OperationID] = call.Result.(*ListZonesResponse)
+
+			return nil
+		}
+	}
+
+	client, requests := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "" || r.Header.Get("X-Audit") != "test" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"zones":[{"name":"ch-gva-2","api-endpoint":"https://api-ch-gva-2.exoscale.com/v2"}]}`))
+	}, ClientOptWithMiddlewares(audit, caching))
+
+	// The list-zones operation is not signed: sign the other ones through the middleware.
+	client = client.WithMiddleware(func(next Handler) Handler {
+		return func(ctx context.Context, call *Call) error {
+			call.Request.Header.Set("Authorization", "test")
+			return next(ctx, call)
+		}
+	})
+
+	first, err := client.ListZones(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := client.ListZones(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(first, second) || len(second.Zones) != 1 {
+		t.Errorf("expected cached result %+v, got %+v", first, second)
+	}
+	if got := atomic.LoadInt32(requests); got != 1 {
+		t.Errorf("expected a single request to be sent, got %d", got)
+	}
+	if !reflect.DeepEqual(order, []string{"audit", "cache", "audit", "cache"}) {
+		t.Errorf("unexpected middlewares call order: %v", order)
+	}
+}
+
+func TestMiddlewareSeesErrors(t *testing.T) {
+	var seen error
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	},
+		ClientOptWithMiddlewares(func(next Handler) Handler {
+			return func(ctx context.Context, call *Call) error {
+				seen = next(ctx, call)
+				return seen
+			}
+		}),
+	)
+
+	_, err := client.GetInstance(context.Background(), UUID("bb2dcf4a-3e1b-4ce9-8f3c-6a8b1d5f0a27"))
+	if !errors.Is(err, ErrNotFound) || !errors.Is(seen, ErrNotFound) {
+		t.Errorf("expected %v to be returned and seen by the middleware, got %v and %v", ErrNotFound, err, seen)
+	}
+}
@@ -6,6 +6,7 @@ package v3
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net"
 	"net/http"
 	"net/url"
@@ -46,33 +47,41 @@ func (c Client) ListAIAPIKeys(ctx context.Context) (*ListAIAPIKeysResponse, erro
 		return nil, fmt.Errorf("ListAIAPIKeys: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListAIAPIKeys: sign request: %w", err)
+	bodyresp := new(ListAIAPIKeysResponse)
+	if err := c.do(ctx, "list-ai-api-keys", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ListAIAPIKeys: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "list-ai-api-keys")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ListAIAPIKeys: http client do: %w", err)
-	}
+// ListAIAPIKeysSeq returns an iterator over the elements listed by ListAIAPIKeys,
+// decoded while the response is read. The iteration stops at the first error,
+// yielded with a zero element, or once the context is done.
+func (c Client) ListAIAPIKeysSeq(ctx context.Context) iter.Seq2[ListAIAPIKeysResponseEntry, error] {
+	return func(yield func(ListAIAPIKeysResponseEntry, error) bool) {
+		path := "/ai/api-key"
 
-	if c.trace {
-		dumpResponse(response)
-	}
+		request, err := http.NewRequestWithContext(ctx, "GET", c.serverEndpoint+path, nil)
+		if err != nil {
+			yieldError(yield, fmt.Errorf("ListAIAPIKeysSeq: new request: %w", err))
+			return
+		}
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ListAIAPIKeys: http response: %w", err)
-	}
+		request.Header.Add("User-Agent", c.getUserAgent())
 
-	bodyresp := new(ListAIAPIKeysResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ListAIAPIKeys: prepare JSON response: %w", err)
-	}
+		if err := c.executeRequestInterceptors(ctx, request); err != nil {
+			yieldError(yield, fmt.Errorf("ListAIAPIKeysSeq: execute request editors: %w", err))
+			return
+		}
 
-	return bodyresp, nil
+		bodyresp := new(ListAIAPIKeysResponse)
+		call := &Call{OperationID: "list-ai-api-keys", Request: request, Result: bodyresp}
+		elems := func() []ListAIAPIKeysResponseEntry { return bodyresp.AIAPIKeys }
+		if err := streamList(ctx, c, call, true, "ai-api-keys", elems, yield); err != nil {
+			yieldError(yield, fmt.Errorf("ListAIAPIKeysSeq: %w", err))
+		}
+	}
 }
 
 // Create a new AI API key
@@ -97,30 +106,9 @@ func (c Client) CreateAIAPIKey(ctx context.Context, req CreateAIAPIKeyRequest) (
 		return nil, fmt.Errorf("CreateAIAPIKey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateAIAPIKey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-ai-api-key")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("CreateAIAPIKey: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateAIAPIKey: http response: %w", err)
-	}
-
 	bodyresp := new(CreateAIAPIKeyResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateAIAPIKey: prepare JSON response: %w", err)
+	if err := c.do(ctx, "create-ai-api-key", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateAIAPIKey: %w", err)
 	}
 
 	return bodyresp, nil
@@ -141,30 +129,9 @@ func (c Client) DeleteAIAPIKey(ctx context.Context, id UUID) (*Operation, error)
 		return nil, fmt.Errorf("DeleteAIAPIKey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteAIAPIKey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-ai-api-key")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteAIAPIKey: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteAIAPIKey: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteAIAPIKey: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-ai-api-key", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteAIAPIKey: %w", err)
 	}
 
 	return bodyresp, nil
@@ -185,30 +152,9 @@ func (c Client) GetAIAPIKey(ctx context.Context, id UUID) (*GetAIAPIKeyResponse,
 		return nil, fmt.Errorf("GetAIAPIKey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetAIAPIKey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-ai-api-key")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetAIAPIKey: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetAIAPIKey: http response: %w", err)
-	}
-
 	bodyresp := new(GetAIAPIKeyResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetAIAPIKey: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-ai-api-key", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetAIAPIKey: %w", err)
 	}
 
 	return bodyresp, nil
@@ -236,30 +182,9 @@ func (c Client) UpdateAIAPIKey(ctx context.Context, id UUID, req UpdateAIAPIKeyR
 		return nil, fmt.Errorf("UpdateAIAPIKey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateAIAPIKey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-ai-api-key")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("UpdateAIAPIKey: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("UpdateAIAPIKey: http response: %w", err)
-	}
-
 	bodyresp := new(UpdateAIAPIKeyResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("UpdateAIAPIKey: prepare JSON response: %w", err)
+	if err := c.do(ctx, "update-ai-api-key", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateAIAPIKey: %w", err)
 	}
 
 	return bodyresp, nil
@@ -280,30 +205,9 @@ func (c Client) RevealAIAPIKey(ctx context.Context, id UUID) (*RevealAIAPIKeyRes
 		return nil, fmt.Errorf("RevealAIAPIKey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RevealAIAPIKey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reveal-ai-api-key")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("RevealAIAPIKey: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("RevealAIAPIKey: http response: %w", err)
-	}
-
 	bodyresp := new(RevealAIAPIKeyResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("RevealAIAPIKey: prepare JSON response: %w", err)
+	if err := c.do(ctx, "reveal-ai-api-key", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("RevealAIAPIKey: %w", err)
 	}
 
 	return bodyresp, nil
@@ -324,30 +228,9 @@ func (c Client) RotateAIAPIKey(ctx context.Context, id UUID) (*RotateAIAPIKeyRes
 		return nil, fmt.Errorf("RotateAIAPIKey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RotateAIAPIKey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "rotate-ai-api-key")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("RotateAIAPIKey: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("RotateAIAPIKey: http response: %w", err)
-	}
-
 	bodyresp := new(RotateAIAPIKeyResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("RotateAIAPIKey: prepare JSON response: %w", err)
+	if err := c.do(ctx, "rotate-ai-api-key", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("RotateAIAPIKey: %w", err)
 	}
 
 	return bodyresp, nil
@@ -403,33 +286,49 @@ func (c Client) ListDeployments(ctx context.Context, opts ...ListDeploymentsOpt)
 		return nil, fmt.Errorf("ListDeployments: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListDeployments: sign request: %w", err)
+	bodyresp := new(ListDeploymentsResponse)
+	if err := c.do(ctx, "list-deployments", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ListDeployments: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "list-deployments")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ListDeployments: http client do: %w", err)
-	}
+// ListDeploymentsSeq returns an iterator over the elements listed by ListDeployments,
+// decoded while the response is read. The iteration stops at the first error,
+// yielded with a zero element, or once the context is done.
+func (c Client) ListDeploymentsSeq(ctx context.Context, opts ...ListDeploymentsOpt) iter.Seq2[ListDeploymentsResponseEntry, error] {
+	return func(yield func(ListDeploymentsResponseEntry, error) bool) {
+		path := "/ai/deployment"
 
-	if c.trace {
-		dumpResponse(response)
-	}
+		request, err := http.NewRequestWithContext(ctx, "GET", c.serverEndpoint+path, nil)
+		if err != nil {
+			yieldError(yield, fmt.Errorf("ListDeploymentsSeq: new request: %w", err))
+			return
+		}
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ListDeployments: http response: %w", err)
-	}
+		request.Header.Add("User-Agent", c.getUserAgent())
 
-	bodyresp := new(ListDeploymentsResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ListDeployments: prepare JSON response: %w", err)
-	}
+		if len(opts) > 0 {
+			q := request.URL.Query()
+			for _, opt := range opts {
+				opt(q)
+			}
+			request.URL.RawQuery = q.Encode()
+		}
 
-	return bodyresp, nil
+		if err := c.executeRequestInterceptors(ctx, request); err != nil {
+			yieldError(yield, fmt.Errorf("ListDeploymentsSeq: execute request editors: %w", err))
+			return
+		}
+
+		bodyresp := new(ListDeploymentsResponse)
+		call := &Call{OperationID: "list-deployments", Request: request, Result: bodyresp}
+		elems := func() []ListDeploymentsResponseEntry { return bodyresp.Deployments }
+		if err := streamList(ctx, c, call, true, "deployments", elems, yield); err != nil {
+			yieldError(yield, fmt.Errorf("ListDeploymentsSeq: %w", err))
+		}
+	}
 }
 
 // Deploy a model on an inference server
@@ -454,33 +353,40 @@ func (c Client) CreateDeployment(ctx context.Context, req CreateDeploymentReques
 		return nil, fmt.Errorf("CreateDeployment: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDeployment: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "create-deployment", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDeployment: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "create-deployment")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// CreateDeploymentAndWait calls CreateDeployment, waits for the operation to succeed
+// and returns the created resource.
+func (c Client) CreateDeploymentAndWait(ctx context.Context, req CreateDeploymentRequest) (*GetDeploymentResponse, error) {
+	op, err := c.CreateDeployment(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("CreateDeployment: http client do: %w", err)
+		return nil, fmt.Errorf("CreateDeploymentAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateDeployment: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("CreateDeploymentAndWait: %w", &OperationError{ID: op.ID, Err: err})
+	}
+	if done.Reference == nil {
+		return nil, fmt.Errorf("CreateDeploymentAndWait: %w", &OperationError{ID: op.ID, Err: ErrMissingOperationReference})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateDeployment: prepare JSON response: %w", err)
+	resp, err := c.GetDeployment(ctx, done.Reference.ID)
+	if err != nil {
+		return nil, fmt.Errorf("CreateDeploymentAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 // Delete Deployment
@@ -498,30 +404,9 @@ func (c Client) DeleteDeployment(ctx context.Context, id UUID) (*Operation, erro
 		return nil, fmt.Errorf("DeleteDeployment: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDeployment: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-deployment")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteDeployment: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteDeployment: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteDeployment: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-deployment", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDeployment: %w", err)
 	}
 
 	return bodyresp, nil
@@ -542,30 +427,9 @@ func (c Client) GetDeployment(ctx context.Context, id UUID) (*GetDeploymentRespo
 		return nil, fmt.Errorf("GetDeployment: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDeployment: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-deployment")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetDeployment: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetDeployment: http response: %w", err)
-	}
-
 	bodyresp := new(GetDeploymentResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetDeployment: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-deployment", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDeployment: %w", err)
 	}
 
 	return bodyresp, nil
@@ -593,30 +457,9 @@ func (c Client) UpdateDeployment(ctx context.Context, id UUID, req UpdateDeploym
 		return nil, fmt.Errorf("UpdateDeployment: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDeployment: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-deployment")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("UpdateDeployment: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("UpdateDeployment: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("UpdateDeployment: prepare JSON response: %w", err)
+	if err := c.do(ctx, "update-deployment", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateDeployment: %w", err)
 	}
 
 	return bodyresp, nil
@@ -637,30 +480,9 @@ func (c Client) RevealDeploymentAPIKey(ctx context.Context, id UUID) (*RevealDep
 		return nil, fmt.Errorf("RevealDeploymentAPIKey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RevealDeploymentAPIKey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reveal-deployment-api-key")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("RevealDeploymentAPIKey: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("RevealDeploymentAPIKey: http response: %w", err)
-	}
-
 	bodyresp := new(RevealDeploymentAPIKeyResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("RevealDeploymentAPIKey: prepare JSON response: %w", err)
+	if err := c.do(ctx, "reveal-deployment-api-key", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("RevealDeploymentAPIKey: %w", err)
 	}
 
 	return bodyresp, nil
@@ -703,30 +525,9 @@ func (c Client) GetDeploymentLogs(ctx context.Context, id UUID, opts ...GetDeplo
 		return nil, fmt.Errorf("GetDeploymentLogs: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDeploymentLogs: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-deployment-logs")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetDeploymentLogs: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetDeploymentLogs: http response: %w", err)
-	}
-
 	bodyresp := new(GetDeploymentLogsResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetDeploymentLogs: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-deployment-logs", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDeploymentLogs: %w", err)
 	}
 
 	return bodyresp, nil
@@ -754,30 +555,9 @@ func (c Client) ScaleDeployment(ctx context.Context, id UUID, req ScaleDeploymen
 		return nil, fmt.Errorf("ScaleDeployment: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ScaleDeployment: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "scale-deployment")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ScaleDeployment: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ScaleDeployment: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ScaleDeployment: prepare JSON response: %w", err)
+	if err := c.do(ctx, "scale-deployment", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ScaleDeployment: %w", err)
 	}
 
 	return bodyresp, nil
@@ -814,30 +594,9 @@ func (c Client) GetInferenceEngineHelp(ctx context.Context, opts ...GetInference
 		return nil, fmt.Errorf("GetInferenceEngineHelp: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetInferenceEngineHelp: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-inference-engine-help")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetInferenceEngineHelp: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetInferenceEngineHelp: http response: %w", err)
-	}
-
 	bodyresp := new(GetInferenceEngineHelpResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetInferenceEngineHelp: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-inference-engine-help", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetInferenceEngineHelp: %w", err)
 	}
 
 	return bodyresp, nil
@@ -858,33 +617,41 @@ func (c Client) ListAIInstanceTypes(ctx context.Context) (*ListAIInstanceTypesRe
 		return nil, fmt.Errorf("ListAIInstanceTypes: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListAIInstanceTypes: sign request: %w", err)
+	bodyresp := new(ListAIInstanceTypesResponse)
+	if err := c.do(ctx, "list-ai-instance-types", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ListAIInstanceTypes: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "list-ai-instance-types")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ListAIInstanceTypes: http client do: %w", err)
-	}
+// ListAIInstanceTypesSeq returns an iterator over the elements listed by ListAIInstanceTypes,
+// decoded while the response is read. The iteration stops at the first error,
+// yielded with a zero element, or once the context is done.
+func (c Client) ListAIInstanceTypesSeq(ctx context.Context) iter.Seq2[InstanceTypeEntry, error] {
+	return func(yield func(InstanceTypeEntry, error) bool) {
+		path := "/ai/instance-type"
 
-	if c.trace {
-		dumpResponse(response)
-	}
+		request, err := http.NewRequestWithContext(ctx, "GET", c.serverEndpoint+path, nil)
+		if err != nil {
+			yieldError(yield, fmt.Errorf("ListAIInstanceTypesSeq: new request: %w", err))
+			return
+		}
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ListAIInstanceTypes: http response: %w", err)
-	}
+		request.Header.Add("User-Agent", c.getUserAgent())
 
-	bodyresp := new(ListAIInstanceTypesResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ListAIInstanceTypes: prepare JSON response: %w", err)
-	}
+		if err := c.executeRequestInterceptors(ctx, request); err != nil {
+			yieldError(yield, fmt.Errorf("ListAIInstanceTypesSeq: execute request editors: %w", err))
+			return
+		}
 
-	return bodyresp, nil
+		bodyresp := new(ListAIInstanceTypesResponse)
+		call := &Call{OperationID: "list-ai-instance-types", Request: request, Result: bodyresp}
+		elems := func() []InstanceTypeEntry { return bodyresp.InstanceTypes }
+		if err := streamList(ctx, c, call, true, "instance-types", elems, yield); err != nil {
+			yieldError(yield, fmt.Errorf("ListAIInstanceTypesSeq: %w", err))
+		}
+	}
 }
 
 // FindListModelsResponseEntry attempts to find an ListModelsResponseEntry by nameOrID.
@@ -921,33 +688,41 @@ func (c Client) ListModels(ctx context.Context) (*ListModelsResponse, error) {
 		return nil, fmt.Errorf("ListModels: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListModels: sign request: %w", err)
+	bodyresp := new(ListModelsResponse)
+	if err := c.do(ctx, "list-models", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ListModels: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "list-models")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ListModels: http client do: %w", err)
-	}
+// ListModelsSeq returns an iterator over the elements listed by ListModels,
+// decoded while the response is read. The iteration stops at the first error,
+// yielded with a zero element, or once the context is done.
+func (c Client) ListModelsSeq(ctx context.Context) iter.Seq2[ListModelsResponseEntry, error] {
+	return func(yield func(ListModelsResponseEntry, error) bool) {
+		path := "/ai/model"
 
-	if c.trace {
-		dumpResponse(response)
-	}
+		request, err := http.NewRequestWithContext(ctx, "GET", c.serverEndpoint+path, nil)
+		if err != nil {
+			yieldError(yield, fmt.Errorf("ListModelsSeq: new request: %w", err))
+			return
+		}
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ListModels: http response: %w", err)
-	}
+		request.Header.Add("User-Agent", c.getUserAgent())
 
-	bodyresp := new(ListModelsResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ListModels: prepare JSON response: %w", err)
-	}
+		if err := c.executeRequestInterceptors(ctx, request); err != nil {
+			yieldError(yield, fmt.Errorf("ListModelsSeq: execute request editors: %w", err))
+			return
+		}
 
-	return bodyresp, nil
+		bodyresp := new(ListModelsResponse)
+		call := &Call{OperationID: "list-models", Request: request, Result: bodyresp}
+		elems := func() []ListModelsResponseEntry { return bodyresp.Models }
+		if err := streamList(ctx, c, call, true, "models", elems, yield); err != nil {
+			yieldError(yield, fmt.Errorf("ListModelsSeq: %w", err))
+		}
+	}
 }
 
 // Model files will be downloaded from Huggingface.
@@ -974,33 +749,40 @@ func (c Client) CreateModel(ctx context.Context, req CreateModelRequest) (*Opera
 		return nil, fmt.Errorf("CreateModel: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateModel: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "create-model", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateModel: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "create-model")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// CreateModelAndWait calls CreateModel, waits for the operation to succeed
+// and returns the created resource.
+func (c Client) CreateModelAndWait(ctx context.Context, req CreateModelRequest) (*GetModelResponse, error) {
+	op, err := c.CreateModel(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("CreateModel: http client do: %w", err)
+		return nil, fmt.Errorf("CreateModelAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateModel: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("CreateModelAndWait: %w", &OperationError{ID: op.ID, Err: err})
+	}
+	if done.Reference == nil {
+		return nil, fmt.Errorf("CreateModelAndWait: %w", &OperationError{ID: op.ID, Err: ErrMissingOperationReference})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateModel: prepare JSON response: %w", err)
+	resp, err := c.GetModel(ctx, done.Reference.ID)
+	if err != nil {
+		return nil, fmt.Errorf("CreateModelAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 // Delete Model
@@ -1018,30 +800,9 @@ func (c Client) DeleteModel(ctx context.Context, id UUID) (*Operation, error) {
 		return nil, fmt.Errorf("DeleteModel: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteModel: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-model")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteModel: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteModel: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteModel: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-model", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteModel: %w", err)
 	}
 
 	return bodyresp, nil
@@ -1062,30 +823,9 @@ func (c Client) GetModel(ctx context.Context, id UUID) (*GetModelResponse, error
 		return nil, fmt.Errorf("GetModel: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetModel: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-model")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetModel: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetModel: http response: %w", err)
-	}
-
 	bodyresp := new(GetModelResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetModel: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-model", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetModel: %w", err)
 	}
 
 	return bodyresp, nil
@@ -1106,30 +846,9 @@ func (c Client) GetUserOrgConsumptionQuota(ctx context.Context) (*OrgConsumption
 		return nil, fmt.Errorf("GetUserOrgConsumptionQuota: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetUserOrgConsumptionQuota: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-user-org-consumption-quota")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetUserOrgConsumptionQuota: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetUserOrgConsumptionQuota: http response: %w", err)
-	}
-
 	bodyresp := new(OrgConsumptionQuotaResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetUserOrgConsumptionQuota: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-user-org-consumption-quota", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetUserOrgConsumptionQuota: %w", err)
 	}
 
 	return bodyresp, nil
@@ -1173,33 +892,41 @@ func (c Client) ListAntiAffinityGroups(ctx context.Context) (*ListAntiAffinityGr
 		return nil, fmt.Errorf("ListAntiAffinityGroups: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListAntiAffinityGroups: sign request: %w", err)
+	bodyresp := new(ListAntiAffinityGroupsResponse)
+	if err := c.do(ctx, "list-anti-affinity-groups", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ListAntiAffinityGroups: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "list-anti-affinity-groups")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ListAntiAffinityGroups: http client do: %w", err)
-	}
+// ListAntiAffinityGroupsSeq returns an iterator over the elements listed by ListAntiAffinityGroups,
+// decoded while the response is read. The iteration stops at the first error,
+// yielded with a zero element, or once the context is done.
+func (c Client) ListAntiAffinityGroupsSeq(ctx context.Context) iter.Seq2[AntiAffinityGroup, error] {
+	return func(yield func(AntiAffinityGroup, error) bool) {
+		path := "/anti-affinity-group"
 
-	if c.trace {
-		dumpResponse(response)
-	}
+		request, err := http.NewRequestWithContext(ctx, "GET", c.serverEndpoint+path, nil)
+		if err != nil {
+			yieldError(yield, fmt.Errorf("ListAntiAffinityGroupsSeq: new request: %w", err))
+			return
+		}
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ListAntiAffinityGroups: http response: %w", err)
-	}
+		request.Header.Add("User-Agent", c.getUserAgent())
 
-	bodyresp := new(ListAntiAffinityGroupsResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ListAntiAffinityGroups: prepare JSON response: %w", err)
-	}
+		if err := c.executeRequestInterceptors(ctx, request); err != nil {
+			yieldError(yield, fmt.Errorf("ListAntiAffinityGroupsSeq: execute request editors: %w", err))
+			return
+		}
 
-	return bodyresp, nil
+		bodyresp := new(ListAntiAffinityGroupsResponse)
+		call := &Call{OperationID: "list-anti-affinity-groups", Request: request, Result: bodyresp}
+		elems := func() []AntiAffinityGroup { return bodyresp.AntiAffinityGroups }
+		if err := streamList(ctx, c, call, true, "anti-affinity-groups", elems, yield); err != nil {
+			yieldError(yield, fmt.Errorf("ListAntiAffinityGroupsSeq: %w", err))
+		}
+	}
 }
 
 type CreateAntiAffinityGroupRequest struct {
@@ -1231,33 +958,40 @@ func (c Client) CreateAntiAffinityGroup(ctx context.Context, req CreateAntiAffin
 		return nil, fmt.Errorf("CreateAntiAffinityGroup: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateAntiAffinityGroup: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "create-anti-affinity-group", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateAntiAffinityGroup: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "create-anti-affinity-group")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// CreateAntiAffinityGroupAndWait calls CreateAntiAffinityGroup, waits for the operation to succeed
+// and returns the created resource.
+func (c Client) CreateAntiAffinityGroupAndWait(ctx context.Context, req CreateAntiAffinityGroupRequest) (*AntiAffinityGroup, error) {
+	op, err := c.CreateAntiAffinityGroup(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("CreateAntiAffinityGroup: http client do: %w", err)
+		return nil, fmt.Errorf("CreateAntiAffinityGroupAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateAntiAffinityGroup: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("CreateAntiAffinityGroupAndWait: %w", &OperationError{ID: op.ID, Err: err})
+	}
+	if done.Reference == nil {
+		return nil, fmt.Errorf("CreateAntiAffinityGroupAndWait: %w", &OperationError{ID: op.ID, Err: ErrMissingOperationReference})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateAntiAffinityGroup: prepare JSON response: %w", err)
+	resp, err := c.GetAntiAffinityGroup(ctx, done.Reference.ID)
+	if err != nil {
+		return nil, fmt.Errorf("CreateAntiAffinityGroupAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 // Delete an Anti-affinity Group
@@ -1275,30 +1009,9 @@ func (c Client) DeleteAntiAffinityGroup(ctx context.Context, id UUID) (*Operatio
 		return nil, fmt.Errorf("DeleteAntiAffinityGroup: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteAntiAffinityGroup: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-anti-affinity-group")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteAntiAffinityGroup: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteAntiAffinityGroup: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteAntiAffinityGroup: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-anti-affinity-group", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteAntiAffinityGroup: %w", err)
 	}
 
 	return bodyresp, nil
@@ -1319,30 +1032,9 @@ func (c Client) GetAntiAffinityGroup(ctx context.Context, id UUID) (*AntiAffinit
 		return nil, fmt.Errorf("GetAntiAffinityGroup: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetAntiAffinityGroup: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-anti-affinity-group")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetAntiAffinityGroup: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetAntiAffinityGroup: http response: %w", err)
-	}
-
 	bodyresp := new(AntiAffinityGroup)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetAntiAffinityGroup: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-anti-affinity-group", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetAntiAffinityGroup: %w", err)
 	}
 
 	return bodyresp, nil
@@ -1385,33 +1077,41 @@ func (c Client) ListAPIKeys(ctx context.Context) (*ListAPIKeysResponse, error) {
 		return nil, fmt.Errorf("ListAPIKeys: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListAPIKeys: sign request: %w", err)
+	bodyresp := new(ListAPIKeysResponse)
+	if err := c.do(ctx, "list-api-keys", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ListAPIKeys: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "list-api-keys")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ListAPIKeys: http client do: %w", err)
-	}
+// ListAPIKeysSeq returns an iterator over the elements listed by ListAPIKeys,
+// decoded while the response is read. The iteration stops at the first error,
+// yielded with a zero element, or once the context is done.
+func (c Client) ListAPIKeysSeq(ctx context.Context) iter.Seq2[IAMAPIKey, error] {
+	return func(yield func(IAMAPIKey, error) bool) {
+		path := "/api-key"
 
-	if c.trace {
-		dumpResponse(response)
-	}
+		request, err := http.NewRequestWithContext(ctx, "GET", c.serverEndpoint+path, nil)
+		if err != nil {
+			yieldError(yield, fmt.Errorf("ListAPIKeysSeq: new request: %w", err))
+			return
+		}
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ListAPIKeys: http response: %w", err)
-	}
+		request.Header.Add("User-Agent", c.getUserAgent())
 
-	bodyresp := new(ListAPIKeysResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ListAPIKeys: prepare JSON response: %w", err)
-	}
+		if err := c.executeRequestInterceptors(ctx, request); err != nil {
+			yieldError(yield, fmt.Errorf("ListAPIKeysSeq: execute request editors: %w", err))
+			return
+		}
 
-	return bodyresp, nil
+		bodyresp := new(ListAPIKeysResponse)
+		call := &Call{OperationID: "list-api-keys", Request: request, Result: bodyresp}
+		elems := func() []IAMAPIKey { return bodyresp.APIKeys }
+		if err := streamList(ctx, c, call, true, "api-keys", elems, yield); err != nil {
+			yieldError(yield, fmt.Errorf("ListAPIKeysSeq: %w", err))
+		}
+	}
 }
 
 type CreateAPIKeyRequest struct {
@@ -1442,30 +1142,9 @@ func (c Client) CreateAPIKey(ctx context.Context, req CreateAPIKeyRequest) (*IAM
 		return nil, fmt.Errorf("CreateAPIKey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateAPIKey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-api-key")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("CreateAPIKey: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateAPIKey: http response: %w", err)
-	}
-
 	bodyresp := new(IAMAPIKeyCreated)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateAPIKey: prepare JSON response: %w", err)
+	if err := c.do(ctx, "create-api-key", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateAPIKey: %w", err)
 	}
 
 	return bodyresp, nil
@@ -1485,30 +1164,9 @@ func (c Client) DeleteAPIKey(ctx context.Context, id string) (*Operation, error)
 		return nil, fmt.Errorf("DeleteAPIKey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteAPIKey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-api-key")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteAPIKey: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteAPIKey: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteAPIKey: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-api-key", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteAPIKey: %w", err)
 	}
 
 	return bodyresp, nil
@@ -1528,30 +1186,9 @@ func (c Client) GetAPIKey(ctx context.Context, id string) (*IAMAPIKey, error) {
 		return nil, fmt.Errorf("GetAPIKey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetAPIKey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-api-key")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetAPIKey: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetAPIKey: http response: %w", err)
-	}
-
 	bodyresp := new(IAMAPIKey)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetAPIKey: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-api-key", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetAPIKey: %w", err)
 	}
 
 	return bodyresp, nil
@@ -1611,33 +1248,49 @@ func (c Client) ListBlockStorageVolumes(ctx context.Context, opts ...ListBlockSt
 		return nil, fmt.Errorf("ListBlockStorageVolumes: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListBlockStorageVolumes: sign request: %w", err)
+	bodyresp := new(ListBlockStorageVolumesResponse)
+	if err := c.do(ctx, "list-block-storage-volumes", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ListBlockStorageVolumes: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "list-block-storage-volumes")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ListBlockStorageVolumes: http client do: %w", err)
-	}
+// ListBlockStorageVolumesSeq returns an iterator over the elements listed by ListBlockStorageVolumes,
+// decoded while the response is read. The iteration stops at the first error,
+// yielded with a zero element, or once the context is done.
+func (c Client) ListBlockStorageVolumesSeq(ctx context.Context, opts ...ListBlockStorageVolumesOpt) iter.Seq2[BlockStorageVolume, error] {
+	return func(yield func(BlockStorageVolume, error) bool) {
+		path := "/block-storage"
 
-	if c.trace {
-		dumpResponse(response)
-	}
+		request, err := http.NewRequestWithContext(ctx, "GET", c.serverEndpoint+path, nil)
+		if err != nil {
+			yieldError(yield, fmt.Errorf("ListBlockStorageVolumesSeq: new request: %w", err))
+			return
+		}
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ListBlockStorageVolumes: http response: %w", err)
-	}
+		request.Header.Add("User-Agent", c.getUserAgent())
 
-	bodyresp := new(ListBlockStorageVolumesResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ListBlockStorageVolumes: prepare JSON response: %w", err)
-	}
+		if len(opts) > 0 {
+			q := request.URL.Query()
+			for _, opt := range opts {
+				opt(q)
+			}
+			request.URL.RawQuery = q.Encode()
+		}
 
-	return bodyresp, nil
+		if err := c.executeRequestInterceptors(ctx, request); err != nil {
+			yieldError(yield, fmt.Errorf("ListBlockStorageVolumesSeq: execute request editors: %w", err))
+			return
+		}
+
+		bodyresp := new(ListBlockStorageVolumesResponse)
+		call := &Call{OperationID: "list-block-storage-volumes", Request: request, Result: bodyresp}
+		elems := func() []BlockStorageVolume { return bodyresp.BlockStorageVolumes }
+		if err := streamList(ctx, c, call, true, "block-storage-volumes", elems, yield); err != nil {
+			yieldError(yield, fmt.Errorf("ListBlockStorageVolumesSeq: %w", err))
+		}
+	}
 }
 
 type CreateBlockStorageVolumeRequest struct {
@@ -1673,33 +1326,40 @@ func (c Client) CreateBlockStorageVolume(ctx context.Context, req CreateBlockSto
 		return nil, fmt.Errorf("CreateBlockStorageVolume: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateBlockStorageVolume: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "create-block-storage-volume", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateBlockStorageVolume: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "create-block-storage-volume")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// CreateBlockStorageVolumeAndWait calls CreateBlockStorageVolume, waits for the operation to succeed
+// and returns the created resource.
+func (c Client) CreateBlockStorageVolumeAndWait(ctx context.Context, req CreateBlockStorageVolumeRequest) (*BlockStorageVolume, error) {
+	op, err := c.CreateBlockStorageVolume(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("CreateBlockStorageVolume: http client do: %w", err)
+		return nil, fmt.Errorf("CreateBlockStorageVolumeAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateBlockStorageVolume: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("CreateBlockStorageVolumeAndWait: %w", &OperationError{ID: op.ID, Err: err})
+	}
+	if done.Reference == nil {
+		return nil, fmt.Errorf("CreateBlockStorageVolumeAndWait: %w", &OperationError{ID: op.ID, Err: ErrMissingOperationReference})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateBlockStorageVolume: prepare JSON response: %w", err)
+	resp, err := c.GetBlockStorageVolume(ctx, done.Reference.ID)
+	if err != nil {
+		return nil, fmt.Errorf("CreateBlockStorageVolumeAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 type ListBlockStorageSnapshotsResponse struct {
@@ -1740,33 +1400,41 @@ func (c Client) ListBlockStorageSnapshots(ctx context.Context) (*ListBlockStorag
 		return nil, fmt.Errorf("ListBlockStorageSnapshots: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListBlockStorageSnapshots: sign request: %w", err)
+	bodyresp := new(ListBlockStorageSnapshotsResponse)
+	if err := c.do(ctx, "list-block-storage-snapshots", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ListBlockStorageSnapshots: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "list-block-storage-snapshots")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ListBlockStorageSnapshots: http client do: %w", err)
-	}
+// ListBlockStorageSnapshotsSeq returns an iterator over the elements listed by ListBlockStorageSnapshots,
+// decoded while the response is read. The iteration stops at the first error,
+// yielded with a zero element, or once the context is done.
+func (c Client) ListBlockStorageSnapshotsSeq(ctx context.Context) iter.Seq2[BlockStorageSnapshot, error] {
+	return func(yield func(BlockStorageSnapshot, error) bool) {
+		path := "/block-storage-snapshot"
 
-	if c.trace {
-		dumpResponse(response)
-	}
+		request, err := http.NewRequestWithContext(ctx, "GET", c.serverEndpoint+path, nil)
+		if err != nil {
+			yieldError(yield, fmt.Errorf("ListBlockStorageSnapshotsSeq: new request: %w", err))
+			return
+		}
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ListBlockStorageSnapshots: http response: %w", err)
-	}
+		request.Header.Add("User-Agent", c.getUserAgent())
 
-	bodyresp := new(ListBlockStorageSnapshotsResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ListBlockStorageSnapshots: prepare JSON response: %w", err)
-	}
+		if err := c.executeRequestInterceptors(ctx, request); err != nil {
+			yieldError(yield, fmt.Errorf("ListBlockStorageSnapshotsSeq: execute request editors: %w", err))
+			return
+		}
 
-	return bodyresp, nil
+		bodyresp := new(ListBlockStorageSnapshotsResponse)
+		call := &Call{OperationID: "list-block-storage-snapshots", Request: request, Result: bodyresp}
+		elems := func() []BlockStorageSnapshot { return bodyresp.BlockStorageSnapshots }
+		if err := streamList(ctx, c, call, true, "block-storage-snapshots", elems, yield); err != nil {
+			yieldError(yield, fmt.Errorf("ListBlockStorageSnapshotsSeq: %w", err))
+		}
+	}
 }
 
 // Delete a block storage snapshot, data will be unrecoverable
@@ -1784,30 +1452,9 @@ func (c Client) DeleteBlockStorageSnapshot(ctx context.Context, id UUID) (*Opera
 		return nil, fmt.Errorf("DeleteBlockStorageSnapshot: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteBlockStorageSnapshot: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-block-storage-snapshot")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteBlockStorageSnapshot: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteBlockStorageSnapshot: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteBlockStorageSnapshot: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-block-storage-snapshot", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteBlockStorageSnapshot: %w", err)
 	}
 
 	return bodyresp, nil
@@ -1828,30 +1475,9 @@ func (c Client) GetBlockStorageSnapshot(ctx context.Context, id UUID) (*BlockSto
 		return nil, fmt.Errorf("GetBlockStorageSnapshot: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetBlockStorageSnapshot: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-block-storage-snapshot")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetBlockStorageSnapshot: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetBlockStorageSnapshot: http response: %w", err)
-	}
-
 	bodyresp := new(BlockStorageSnapshot)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetBlockStorageSnapshot: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-block-storage-snapshot", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetBlockStorageSnapshot: %w", err)
 	}
 
 	return bodyresp, nil
@@ -1885,33 +1511,37 @@ func (c Client) UpdateBlockStorageSnapshot(ctx context.Context, id UUID, req Upd
 		return nil, fmt.Errorf("UpdateBlockStorageSnapshot: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateBlockStorageSnapshot: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "update-block-storage-snapshot", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateBlockStorageSnapshot: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "update-block-storage-snapshot")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// UpdateBlockStorageSnapshotAndWait calls UpdateBlockStorageSnapshot, waits for the operation to succeed
+// and returns the updated resource.
+func (c Client) UpdateBlockStorageSnapshotAndWait(ctx context.Context, id UUID, req UpdateBlockStorageSnapshotRequest) (*BlockStorageSnapshot, error) {
+	op, err := c.UpdateBlockStorageSnapshot(ctx, id, req)
 	if err != nil {
-		return nil, fmt.Errorf("UpdateBlockStorageSnapshot: http client do: %w", err)
+		return nil, fmt.Errorf("UpdateBlockStorageSnapshotAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("UpdateBlockStorageSnapshot: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateBlockStorageSnapshotAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("UpdateBlockStorageSnapshot: prepare JSON response: %w", err)
+	resp, err := c.GetBlockStorageSnapshot(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateBlockStorageSnapshotAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 // Delete a block storage volume, data will be unrecoverable
@@ -1929,30 +1559,9 @@ func (c Client) DeleteBlockStorageVolume(ctx context.Context, id UUID) (*Operati
 		return nil, fmt.Errorf("DeleteBlockStorageVolume: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteBlockStorageVolume: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-block-storage-volume")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteBlockStorageVolume: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteBlockStorageVolume: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteBlockStorageVolume: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-block-storage-volume", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteBlockStorageVolume: %w", err)
 	}
 
 	return bodyresp, nil
@@ -1973,30 +1582,9 @@ func (c Client) GetBlockStorageVolume(ctx context.Context, id UUID) (*BlockStora
 		return nil, fmt.Errorf("GetBlockStorageVolume: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetBlockStorageVolume: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-block-storage-volume")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetBlockStorageVolume: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetBlockStorageVolume: http response: %w", err)
-	}
-
 	bodyresp := new(BlockStorageVolume)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetBlockStorageVolume: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-block-storage-volume", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetBlockStorageVolume: %w", err)
 	}
 
 	return bodyresp, nil
@@ -2030,33 +1618,37 @@ func (c Client) UpdateBlockStorageVolume(ctx context.Context, id UUID, req Updat
 		return nil, fmt.Errorf("UpdateBlockStorageVolume: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateBlockStorageVolume: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "update-block-storage-volume", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateBlockStorageVolume: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "update-block-storage-volume")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// UpdateBlockStorageVolumeAndWait calls UpdateBlockStorageVolume, waits for the operation to succeed
+// and returns the updated resource.
+func (c Client) UpdateBlockStorageVolumeAndWait(ctx context.Context, id UUID, req UpdateBlockStorageVolumeRequest) (*BlockStorageVolume, error) {
+	op, err := c.UpdateBlockStorageVolume(ctx, id, req)
 	if err != nil {
-		return nil, fmt.Errorf("UpdateBlockStorageVolume: http client do: %w", err)
+		return nil, fmt.Errorf("UpdateBlockStorageVolumeAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("UpdateBlockStorageVolume: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateBlockStorageVolumeAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("UpdateBlockStorageVolume: prepare JSON response: %w", err)
+	resp, err := c.GetBlockStorageVolume(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateBlockStorageVolumeAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 type AttachBlockStorageVolumeToInstanceRequest struct {
@@ -2086,30 +1678,9 @@ func (c Client) AttachBlockStorageVolumeToInstance(ctx context.Context, id UUID,
 		return nil, fmt.Errorf("AttachBlockStorageVolumeToInstance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("AttachBlockStorageVolumeToInstance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "attach-block-storage-volume-to-instance")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("AttachBlockStorageVolumeToInstance: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("AttachBlockStorageVolumeToInstance: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("AttachBlockStorageVolumeToInstance: prepare JSON response: %w", err)
+	if err := c.do(ctx, "attach-block-storage-volume-to-instance", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("AttachBlockStorageVolumeToInstance: %w", err)
 	}
 
 	return bodyresp, nil
@@ -2143,30 +1714,9 @@ func (c Client) CreateBlockStorageSnapshot(ctx context.Context, id UUID, req Cre
 		return nil, fmt.Errorf("CreateBlockStorageSnapshot: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateBlockStorageSnapshot: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-block-storage-snapshot")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("CreateBlockStorageSnapshot: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateBlockStorageSnapshot: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateBlockStorageSnapshot: prepare JSON response: %w", err)
+	if err := c.do(ctx, "create-block-storage-snapshot", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateBlockStorageSnapshot: %w", err)
 	}
 
 	return bodyresp, nil
@@ -2187,30 +1737,9 @@ func (c Client) DetachBlockStorageVolume(ctx context.Context, id UUID) (*Operati
 		return nil, fmt.Errorf("DetachBlockStorageVolume: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DetachBlockStorageVolume: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "detach-block-storage-volume")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DetachBlockStorageVolume: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DetachBlockStorageVolume: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DetachBlockStorageVolume: prepare JSON response: %w", err)
+	if err := c.do(ctx, "detach-block-storage-volume", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DetachBlockStorageVolume: %w", err)
 	}
 
 	return bodyresp, nil
@@ -2243,30 +1772,9 @@ func (c Client) ResizeBlockStorageVolume(ctx context.Context, id UUID, req Resiz
 		return nil, fmt.Errorf("ResizeBlockStorageVolume: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResizeBlockStorageVolume: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "resize-block-storage-volume")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ResizeBlockStorageVolume: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ResizeBlockStorageVolume: http response: %w", err)
-	}
-
 	bodyresp := new(BlockStorageVolume)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ResizeBlockStorageVolume: prepare JSON response: %w", err)
+	if err := c.do(ctx, "resize-block-storage-volume", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ResizeBlockStorageVolume: %w", err)
 	}
 
 	return bodyresp, nil
@@ -2293,30 +1801,9 @@ func (c Client) GetConsoleProxyURL(ctx context.Context, id UUID) (*GetConsolePro
 		return nil, fmt.Errorf("GetConsoleProxyURL: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetConsoleProxyURL: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-console-proxy-url")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetConsoleProxyURL: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetConsoleProxyURL: http response: %w", err)
-	}
-
 	bodyresp := new(GetConsoleProxyURLResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetConsoleProxyURL: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-console-proxy-url", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetConsoleProxyURL: %w", err)
 	}
 
 	return bodyresp, nil
@@ -2341,30 +1828,9 @@ func (c Client) GetDBAASCACertificate(ctx context.Context) (*GetDBAASCACertifica
 		return nil, fmt.Errorf("GetDBAASCACertificate: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASCACertificate: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-ca-certificate")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetDBAASCACertificate: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetDBAASCACertificate: http response: %w", err)
-	}
-
 	bodyresp := new(GetDBAASCACertificateResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetDBAASCACertificate: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-dbaas-ca-certificate", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASCACertificate: %w", err)
 	}
 
 	return bodyresp, nil
@@ -2384,30 +1850,9 @@ func (c Client) DeleteDBAASServiceClickhouse(ctx context.Context, name string) (
 		return nil, fmt.Errorf("DeleteDBAASServiceClickhouse: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASServiceClickhouse: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-service-clickhouse")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteDBAASServiceClickhouse: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASServiceClickhouse: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASServiceClickhouse: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-dbaas-service-clickhouse", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASServiceClickhouse: %w", err)
 	}
 
 	return bodyresp, nil
@@ -2427,30 +1872,9 @@ func (c Client) GetDBAASServiceClickhouse(ctx context.Context, name string) (*DB
 		return nil, fmt.Errorf("GetDBAASServiceClickhouse: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceClickhouse: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-service-clickhouse")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceClickhouse: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceClickhouse: http response: %w", err)
-	}
-
 	bodyresp := new(DBAASServiceClickhouse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceClickhouse: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-dbaas-service-clickhouse", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASServiceClickhouse: %w", err)
 	}
 
 	return bodyresp, nil
@@ -2516,33 +1940,37 @@ func (c Client) CreateDBAASServiceClickhouse(ctx context.Context, name string, r
 		return nil, fmt.Errorf("CreateDBAASServiceClickhouse: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASServiceClickhouse: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "create-dbaas-service-clickhouse", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASServiceClickhouse: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "create-dbaas-service-clickhouse")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// CreateDBAASServiceClickhouseAndWait calls CreateDBAASServiceClickhouse, waits for the operation to succeed
+// and returns the created resource.
+func (c Client) CreateDBAASServiceClickhouseAndWait(ctx context.Context, name string, req CreateDBAASServiceClickhouseRequest) (*DBAASServiceClickhouse, error) {
+	op, err := c.CreateDBAASServiceClickhouse(ctx, name, req)
 	if err != nil {
-		return nil, fmt.Errorf("CreateDBAASServiceClickhouse: http client do: %w", err)
+		return nil, fmt.Errorf("CreateDBAASServiceClickhouseAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateDBAASServiceClickhouse: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("CreateDBAASServiceClickhouseAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateDBAASServiceClickhouse: prepare JSON response: %w", err)
+	resp, err := c.GetDBAASServiceClickhouse(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("CreateDBAASServiceClickhouseAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 type UpdateDBAASServiceClickhouseRequestMaintenanceDow string
@@ -2602,33 +2030,37 @@ func (c Client) UpdateDBAASServiceClickhouse(ctx context.Context, name string, r
 		return nil, fmt.Errorf("UpdateDBAASServiceClickhouse: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASServiceClickhouse: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "update-dbaas-service-clickhouse", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASServiceClickhouse: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "update-dbaas-service-clickhouse")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// UpdateDBAASServiceClickhouseAndWait calls UpdateDBAASServiceClickhouse, waits for the operation to succeed
+// and returns the updated resource.
+func (c Client) UpdateDBAASServiceClickhouseAndWait(ctx context.Context, name string, req UpdateDBAASServiceClickhouseRequest) (*DBAASServiceClickhouse, error) {
+	op, err := c.UpdateDBAASServiceClickhouse(ctx, name, req)
 	if err != nil {
-		return nil, fmt.Errorf("UpdateDBAASServiceClickhouse: http client do: %w", err)
+		return nil, fmt.Errorf("UpdateDBAASServiceClickhouseAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASServiceClickhouse: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateDBAASServiceClickhouseAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASServiceClickhouse: prepare JSON response: %w", err)
+	resp, err := c.GetDBAASServiceClickhouse(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateDBAASServiceClickhouseAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 func (c Client) StartDBAASClickhouseMaintenance(ctx context.Context, name string) (*Operation, error) {
@@ -2645,30 +2077,9 @@ func (c Client) StartDBAASClickhouseMaintenance(ctx context.Context, name string
 		return nil, fmt.Errorf("StartDBAASClickhouseMaintenance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("StartDBAASClickhouseMaintenance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "start-dbaas-clickhouse-maintenance")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("StartDBAASClickhouseMaintenance: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("StartDBAASClickhouseMaintenance: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("StartDBAASClickhouseMaintenance: prepare JSON response: %w", err)
+	if err := c.do(ctx, "start-dbaas-clickhouse-maintenance", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("StartDBAASClickhouseMaintenance: %w", err)
 	}
 
 	return bodyresp, nil
@@ -2688,30 +2099,9 @@ func (c Client) GetDBAASClickhouseAclConfig(ctx context.Context, serviceName str
 		return nil, fmt.Errorf("GetDBAASClickhouseAclConfig: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASClickhouseAclConfig: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-clickhouse-acl-config")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetDBAASClickhouseAclConfig: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetDBAASClickhouseAclConfig: http response: %w", err)
-	}
-
 	bodyresp := new(DBAASClickhouseAclConfig)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetDBAASClickhouseAclConfig: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-dbaas-clickhouse-acl-config", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASClickhouseAclConfig: %w", err)
 	}
 
 	return bodyresp, nil
@@ -2731,33 +2121,41 @@ func (c Client) ListDBAASClickhouseUsers(ctx context.Context, serviceName string
 		return nil, fmt.Errorf("ListDBAASClickhouseUsers: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListDBAASClickhouseUsers: sign request: %w", err)
+	bodyresp := new(DBAASClickhouseUsers)
+	if err := c.do(ctx, "list-dbaas-clickhouse-users", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ListDBAASClickhouseUsers: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "list-dbaas-clickhouse-users")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ListDBAASClickhouseUsers: http client do: %w", err)
-	}
+// ListDBAASClickhouseUsersSeq returns an iterator over the elements listed by ListDBAASClickhouseUsers,
+// decoded while the response is read. The iteration stops at the first error,
+// yielded with a zero element, or once the context is done.
+func (c Client) ListDBAASClickhouseUsersSeq(ctx context.Context, serviceName string) iter.Seq2[DBAASClickhouseUser, error] {
+	return func(yield func(DBAASClickhouseUser, error) bool) {
+		path := fmt.Sprintf("/dbaas-clickhouse/%v/user", serviceName)
 
-	if c.trace {
-		dumpResponse(response)
-	}
+		request, err := http.NewRequestWithContext(ctx, "GET", c.serverEndpoint+path, nil)
+		if err != nil {
+			yieldError(yield, fmt.Errorf("ListDBAASClickhouseUsersSeq: new request: %w", err))
+			return
+		}
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ListDBAASClickhouseUsers: http response: %w", err)
-	}
+		request.Header.Add("User-Agent", c.getUserAgent())
 
-	bodyresp := new(DBAASClickhouseUsers)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ListDBAASClickhouseUsers: prepare JSON response: %w", err)
-	}
+		if err := c.executeRequestInterceptors(ctx, request); err != nil {
+			yieldError(yield, fmt.Errorf("ListDBAASClickhouseUsersSeq: execute request editors: %w", err))
+			return
+		}
 
-	return bodyresp, nil
+		bodyresp := new(DBAASClickhouseUsers)
+		call := &Call{OperationID: "list-dbaas-clickhouse-users", Request: request, Result: bodyresp}
+		elems := func() []DBAASClickhouseUser { return bodyresp.Users }
+		if err := streamList(ctx, c, call, true, "users", elems, yield); err != nil {
+			yieldError(yield, fmt.Errorf("ListDBAASClickhouseUsersSeq: %w", err))
+		}
+	}
 }
 
 type CreateDBAASClickhouseUserRequest struct {
@@ -2788,30 +2186,9 @@ func (c Client) CreateDBAASClickhouseUser(ctx context.Context, serviceName strin
 		return nil, fmt.Errorf("CreateDBAASClickhouseUser: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASClickhouseUser: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-clickhouse-user")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("CreateDBAASClickhouseUser: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateDBAASClickhouseUser: http response: %w", err)
-	}
-
 	bodyresp := new(DBAASUserClickhouseSecrets)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateDBAASClickhouseUser: prepare JSON response: %w", err)
+	if err := c.do(ctx, "create-dbaas-clickhouse-user", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASClickhouseUser: %w", err)
 	}
 
 	return bodyresp, nil
@@ -2831,30 +2208,9 @@ func (c Client) DeleteDBAASClickhouseUser(ctx context.Context, serviceName strin
 		return nil, fmt.Errorf("DeleteDBAASClickhouseUser: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASClickhouseUser: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-clickhouse-user")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteDBAASClickhouseUser: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASClickhouseUser: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASClickhouseUser: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-dbaas-clickhouse-user", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASClickhouseUser: %w", err)
 	}
 
 	return bodyresp, nil
@@ -2885,30 +2241,9 @@ func (c Client) ResetDBAASClickhouseUserPassword(ctx context.Context, serviceNam
 		return nil, fmt.Errorf("ResetDBAASClickhouseUserPassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetDBAASClickhouseUserPassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-dbaas-clickhouse-user-password")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ResetDBAASClickhouseUserPassword: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ResetDBAASClickhouseUserPassword: http response: %w", err)
-	}
-
 	bodyresp := new(DBAASUserClickhouseSecrets)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ResetDBAASClickhouseUserPassword: prepare JSON response: %w", err)
+	if err := c.do(ctx, "reset-dbaas-clickhouse-user-password", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ResetDBAASClickhouseUserPassword: %w", err)
 	}
 
 	return bodyresp, nil
@@ -2928,74 +2263,32 @@ func (c Client) RevealDBAASClickhouseUserPassword(ctx context.Context, serviceNa
 		return nil, fmt.Errorf("RevealDBAASClickhouseUserPassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RevealDBAASClickhouseUserPassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reveal-dbaas-clickhouse-user-password")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("RevealDBAASClickhouseUserPassword: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("RevealDBAASClickhouseUserPassword: http response: %w", err)
-	}
-
 	bodyresp := new(DBAASUserClickhouseSecrets)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("RevealDBAASClickhouseUserPassword: prepare JSON response: %w", err)
+	if err := c.do(ctx, "reveal-dbaas-clickhouse-user-password", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("RevealDBAASClickhouseUserPassword: %w", err)
 	}
 
 	return bodyresp, nil
 }
 
-// [BETA] Delete DataDog external integration endpoint
-func (c Client) DeleteDBAASExternalEndpointDatadog(ctx context.Context, endpointID UUID) (*Operation, error) {
-	path := fmt.Sprintf("/dbaas-external-endpoint-datadog/%v", endpointID)
-
-	request, err := http.NewRequestWithContext(ctx, "DELETE", c.serverEndpoint+path, nil)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteDBAASExternalEndpointDatadog: new request: %w", err)
-	}
-
-	request.Header.Add("User-Agent", c.getUserAgent())
-
-	if err := c.executeRequestInterceptors(ctx, request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASExternalEndpointDatadog: execute request editors: %w", err)
-	}
-
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASExternalEndpointDatadog: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-external-endpoint-datadog")
-	}
+// [BETA] Delete DataDog external integration endpoint
+func (c Client) DeleteDBAASExternalEndpointDatadog(ctx context.Context, endpointID UUID) (*Operation, error) {
+	path := fmt.Sprintf("/dbaas-external-endpoint-datadog/%v", endpointID)
 
-	response, err := c.httpClient.Do(request)
+	request, err := http.NewRequestWithContext(ctx, "DELETE", c.serverEndpoint+path, nil)
 	if err != nil {
-		return nil, fmt.Errorf("DeleteDBAASExternalEndpointDatadog: http client do: %w", err)
+		return nil, fmt.Errorf("DeleteDBAASExternalEndpointDatadog: new request: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
+	request.Header.Add("User-Agent", c.getUserAgent())
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASExternalEndpointDatadog: http response: %w", err)
+	if err := c.executeRequestInterceptors(ctx, request); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASExternalEndpointDatadog: execute request editors: %w", err)
 	}
 
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASExternalEndpointDatadog: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-dbaas-external-endpoint-datadog", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASExternalEndpointDatadog: %w", err)
 	}
 
 	return bodyresp, nil
@@ -3016,30 +2309,9 @@ func (c Client) GetDBAASExternalEndpointDatadog(ctx context.Context, endpointID
 		return nil, fmt.Errorf("GetDBAASExternalEndpointDatadog: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASExternalEndpointDatadog: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-external-endpoint-datadog")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetDBAASExternalEndpointDatadog: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetDBAASExternalEndpointDatadog: http response: %w", err)
-	}
-
 	bodyresp := new(DBAASExternalEndpointDatadogOutput)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetDBAASExternalEndpointDatadog: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-dbaas-external-endpoint-datadog", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASExternalEndpointDatadog: %w", err)
 	}
 
 	return bodyresp, nil
@@ -3067,33 +2339,37 @@ func (c Client) UpdateDBAASExternalEndpointDatadog(ctx context.Context, endpoint
 		return nil, fmt.Errorf("UpdateDBAASExternalEndpointDatadog: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASExternalEndpointDatadog: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "update-dbaas-external-endpoint-datadog", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASExternalEndpointDatadog: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "update-dbaas-external-endpoint-datadog")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// UpdateDBAASExternalEndpointDatadogAndWait calls UpdateDBAASExternalEndpointDatadog, waits for the operation to succeed
+// and returns the updated resource.
+func (c Client) UpdateDBAASExternalEndpointDatadogAndWait(ctx context.Context, endpointID UUID, req DBAASEndpointDatadogInputUpdate) (*DBAASExternalEndpointDatadogOutput, error) {
+	op, err := c.UpdateDBAASExternalEndpointDatadog(ctx, endpointID, req)
 	if err != nil {
-		return nil, fmt.Errorf("UpdateDBAASExternalEndpointDatadog: http client do: %w", err)
+		return nil, fmt.Errorf("UpdateDBAASExternalEndpointDatadogAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASExternalEndpointDatadog: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateDBAASExternalEndpointDatadogAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASExternalEndpointDatadog: prepare JSON response: %w", err)
+	resp, err := c.GetDBAASExternalEndpointDatadog(ctx, endpointID)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateDBAASExternalEndpointDatadogAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 // [BETA] Create DataDog external integration endpoint
@@ -3118,30 +2394,9 @@ func (c Client) CreateDBAASExternalEndpointDatadog(ctx context.Context, name str
 		return nil, fmt.Errorf("CreateDBAASExternalEndpointDatadog: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASExternalEndpointDatadog: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-external-endpoint-datadog")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("CreateDBAASExternalEndpointDatadog: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateDBAASExternalEndpointDatadog: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateDBAASExternalEndpointDatadog: prepare JSON response: %w", err)
+	if err := c.do(ctx, "create-dbaas-external-endpoint-datadog", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASExternalEndpointDatadog: %w", err)
 	}
 
 	return bodyresp, nil
@@ -3162,30 +2417,9 @@ func (c Client) DeleteDBAASExternalEndpointElasticsearch(ctx context.Context, en
 		return nil, fmt.Errorf("DeleteDBAASExternalEndpointElasticsearch: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASExternalEndpointElasticsearch: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-external-endpoint-elasticsearch")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteDBAASExternalEndpointElasticsearch: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASExternalEndpointElasticsearch: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASExternalEndpointElasticsearch: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-dbaas-external-endpoint-elasticsearch", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASExternalEndpointElasticsearch: %w", err)
 	}
 
 	return bodyresp, nil
@@ -3206,30 +2440,9 @@ func (c Client) GetDBAASExternalEndpointElasticsearch(ctx context.Context, endpo
 		return nil, fmt.Errorf("GetDBAASExternalEndpointElasticsearch: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASExternalEndpointElasticsearch: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-external-endpoint-elasticsearch")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetDBAASExternalEndpointElasticsearch: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetDBAASExternalEndpointElasticsearch: http response: %w", err)
-	}
-
 	bodyresp := new(DBAASEndpointElasticsearchOutput)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetDBAASExternalEndpointElasticsearch: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-dbaas-external-endpoint-elasticsearch", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASExternalEndpointElasticsearch: %w", err)
 	}
 
 	return bodyresp, nil
@@ -3257,33 +2470,37 @@ func (c Client) UpdateDBAASExternalEndpointElasticsearch(ctx context.Context, en
 		return nil, fmt.Errorf("UpdateDBAASExternalEndpointElasticsearch: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASExternalEndpointElasticsearch: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "update-dbaas-external-endpoint-elasticsearch", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASExternalEndpointElasticsearch: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "update-dbaas-external-endpoint-elasticsearch")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// UpdateDBAASExternalEndpointElasticsearchAndWait calls UpdateDBAASExternalEndpointElasticsearch, waits for the operation to succeed
+// and returns the updated resource.
+func (c Client) UpdateDBAASExternalEndpointElasticsearchAndWait(ctx context.Context, endpointID UUID, req DBAASEndpointElasticsearchInputUpdate) (*DBAASEndpointElasticsearchOutput, error) {
+	op, err := c.UpdateDBAASExternalEndpointElasticsearch(ctx, endpointID, req)
 	if err != nil {
-		return nil, fmt.Errorf("UpdateDBAASExternalEndpointElasticsearch: http client do: %w", err)
+		return nil, fmt.Errorf("UpdateDBAASExternalEndpointElasticsearchAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASExternalEndpointElasticsearch: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateDBAASExternalEndpointElasticsearchAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASExternalEndpointElasticsearch: prepare JSON response: %w", err)
+	resp, err := c.GetDBAASExternalEndpointElasticsearch(ctx, endpointID)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateDBAASExternalEndpointElasticsearchAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 // [BETA] Create ElasticSearch Logs external integration endpoint
@@ -3308,30 +2525,9 @@ func (c Client) CreateDBAASExternalEndpointElasticsearch(ctx context.Context, na
 		return nil, fmt.Errorf("CreateDBAASExternalEndpointElasticsearch: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASExternalEndpointElasticsearch: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-external-endpoint-elasticsearch")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("CreateDBAASExternalEndpointElasticsearch: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateDBAASExternalEndpointElasticsearch: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateDBAASExternalEndpointElasticsearch: prepare JSON response: %w", err)
+	if err := c.do(ctx, "create-dbaas-external-endpoint-elasticsearch", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASExternalEndpointElasticsearch: %w", err)
 	}
 
 	return bodyresp, nil
@@ -3352,30 +2548,9 @@ func (c Client) DeleteDBAASExternalEndpointOpensearch(ctx context.Context, endpo
 		return nil, fmt.Errorf("DeleteDBAASExternalEndpointOpensearch: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASExternalEndpointOpensearch: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-external-endpoint-opensearch")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteDBAASExternalEndpointOpensearch: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASExternalEndpointOpensearch: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASExternalEndpointOpensearch: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-dbaas-external-endpoint-opensearch", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASExternalEndpointOpensearch: %w", err)
 	}
 
 	return bodyresp, nil
@@ -3396,30 +2571,9 @@ func (c Client) GetDBAASExternalEndpointOpensearch(ctx context.Context, endpoint
 		return nil, fmt.Errorf("GetDBAASExternalEndpointOpensearch: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASExternalEndpointOpensearch: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-external-endpoint-opensearch")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetDBAASExternalEndpointOpensearch: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetDBAASExternalEndpointOpensearch: http response: %w", err)
-	}
-
 	bodyresp := new(DBAASEndpointOpensearchOutput)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetDBAASExternalEndpointOpensearch: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-dbaas-external-endpoint-opensearch", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASExternalEndpointOpensearch: %w", err)
 	}
 
 	return bodyresp, nil
@@ -3447,33 +2601,37 @@ func (c Client) UpdateDBAASExternalEndpointOpensearch(ctx context.Context, endpo
 		return nil, fmt.Errorf("UpdateDBAASExternalEndpointOpensearch: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASExternalEndpointOpensearch: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "update-dbaas-external-endpoint-opensearch", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASExternalEndpointOpensearch: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "update-dbaas-external-endpoint-opensearch")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// UpdateDBAASExternalEndpointOpensearchAndWait calls UpdateDBAASExternalEndpointOpensearch, waits for the operation to succeed
+// and returns the updated resource.
+func (c Client) UpdateDBAASExternalEndpointOpensearchAndWait(ctx context.Context, endpointID UUID, req DBAASEndpointOpensearchInputUpdate) (*DBAASEndpointOpensearchOutput, error) {
+	op, err := c.UpdateDBAASExternalEndpointOpensearch(ctx, endpointID, req)
 	if err != nil {
-		return nil, fmt.Errorf("UpdateDBAASExternalEndpointOpensearch: http client do: %w", err)
+		return nil, fmt.Errorf("UpdateDBAASExternalEndpointOpensearchAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASExternalEndpointOpensearch: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateDBAASExternalEndpointOpensearchAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASExternalEndpointOpensearch: prepare JSON response: %w", err)
+	resp, err := c.GetDBAASExternalEndpointOpensearch(ctx, endpointID)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateDBAASExternalEndpointOpensearchAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 // [BETA] Create OpenSearch Logs external integration endpoint
@@ -3498,30 +2656,9 @@ func (c Client) CreateDBAASExternalEndpointOpensearch(ctx context.Context, name
 		return nil, fmt.Errorf("CreateDBAASExternalEndpointOpensearch: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASExternalEndpointOpensearch: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-external-endpoint-opensearch")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("CreateDBAASExternalEndpointOpensearch: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateDBAASExternalEndpointOpensearch: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateDBAASExternalEndpointOpensearch: prepare JSON response: %w", err)
+	if err := c.do(ctx, "create-dbaas-external-endpoint-opensearch", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASExternalEndpointOpensearch: %w", err)
 	}
 
 	return bodyresp, nil
@@ -3542,30 +2679,9 @@ func (c Client) DeleteDBAASExternalEndpointPrometheus(ctx context.Context, endpo
 		return nil, fmt.Errorf("DeleteDBAASExternalEndpointPrometheus: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASExternalEndpointPrometheus: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-external-endpoint-prometheus")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteDBAASExternalEndpointPrometheus: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASExternalEndpointPrometheus: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASExternalEndpointPrometheus: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-dbaas-external-endpoint-prometheus", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASExternalEndpointPrometheus: %w", err)
 	}
 
 	return bodyresp, nil
@@ -3586,30 +2702,9 @@ func (c Client) GetDBAASExternalEndpointPrometheus(ctx context.Context, endpoint
 		return nil, fmt.Errorf("GetDBAASExternalEndpointPrometheus: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASExternalEndpointPrometheus: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-external-endpoint-prometheus")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetDBAASExternalEndpointPrometheus: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetDBAASExternalEndpointPrometheus: http response: %w", err)
-	}
-
 	bodyresp := new(DBAASEndpointExternalPrometheusOutput)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetDBAASExternalEndpointPrometheus: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-dbaas-external-endpoint-prometheus", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASExternalEndpointPrometheus: %w", err)
 	}
 
 	return bodyresp, nil
@@ -3637,33 +2732,37 @@ func (c Client) UpdateDBAASExternalEndpointPrometheus(ctx context.Context, endpo
 		return nil, fmt.Errorf("UpdateDBAASExternalEndpointPrometheus: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASExternalEndpointPrometheus: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "update-dbaas-external-endpoint-prometheus", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASExternalEndpointPrometheus: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "update-dbaas-external-endpoint-prometheus")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// UpdateDBAASExternalEndpointPrometheusAndWait calls UpdateDBAASExternalEndpointPrometheus, waits for the operation to succeed
+// and returns the updated resource.
+func (c Client) UpdateDBAASExternalEndpointPrometheusAndWait(ctx context.Context, endpointID UUID, req DBAASEndpointPrometheusPayload) (*DBAASEndpointExternalPrometheusOutput, error) {
+	op, err := c.UpdateDBAASExternalEndpointPrometheus(ctx, endpointID, req)
 	if err != nil {
-		return nil, fmt.Errorf("UpdateDBAASExternalEndpointPrometheus: http client do: %w", err)
+		return nil, fmt.Errorf("UpdateDBAASExternalEndpointPrometheusAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASExternalEndpointPrometheus: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateDBAASExternalEndpointPrometheusAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASExternalEndpointPrometheus: prepare JSON response: %w", err)
+	resp, err := c.GetDBAASExternalEndpointPrometheus(ctx, endpointID)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateDBAASExternalEndpointPrometheusAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 // [BETA] Create Prometheus external integration endpoint
@@ -3688,30 +2787,9 @@ func (c Client) CreateDBAASExternalEndpointPrometheus(ctx context.Context, name
 		return nil, fmt.Errorf("CreateDBAASExternalEndpointPrometheus: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASExternalEndpointPrometheus: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-external-endpoint-prometheus")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("CreateDBAASExternalEndpointPrometheus: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateDBAASExternalEndpointPrometheus: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateDBAASExternalEndpointPrometheus: prepare JSON response: %w", err)
+	if err := c.do(ctx, "create-dbaas-external-endpoint-prometheus", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASExternalEndpointPrometheus: %w", err)
 	}
 
 	return bodyresp, nil
@@ -3732,30 +2810,9 @@ func (c Client) DeleteDBAASExternalEndpointRsyslog(ctx context.Context, endpoint
 		return nil, fmt.Errorf("DeleteDBAASExternalEndpointRsyslog: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASExternalEndpointRsyslog: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-external-endpoint-rsyslog")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteDBAASExternalEndpointRsyslog: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASExternalEndpointRsyslog: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASExternalEndpointRsyslog: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-dbaas-external-endpoint-rsyslog", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASExternalEndpointRsyslog: %w", err)
 	}
 
 	return bodyresp, nil
@@ -3776,30 +2833,9 @@ func (c Client) GetDBAASExternalEndpointRsyslog(ctx context.Context, endpointID
 		return nil, fmt.Errorf("GetDBAASExternalEndpointRsyslog: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASExternalEndpointRsyslog: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-external-endpoint-rsyslog")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetDBAASExternalEndpointRsyslog: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetDBAASExternalEndpointRsyslog: http response: %w", err)
-	}
-
 	bodyresp := new(DBAASExternalEndpointRsyslogOutput)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetDBAASExternalEndpointRsyslog: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-dbaas-external-endpoint-rsyslog", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASExternalEndpointRsyslog: %w", err)
 	}
 
 	return bodyresp, nil
@@ -3827,33 +2863,37 @@ func (c Client) UpdateDBAASExternalEndpointRsyslog(ctx context.Context, endpoint
 		return nil, fmt.Errorf("UpdateDBAASExternalEndpointRsyslog: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASExternalEndpointRsyslog: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "update-dbaas-external-endpoint-rsyslog", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASExternalEndpointRsyslog: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "update-dbaas-external-endpoint-rsyslog")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// UpdateDBAASExternalEndpointRsyslogAndWait calls UpdateDBAASExternalEndpointRsyslog, waits for the operation to succeed
+// and returns the updated resource.
+func (c Client) UpdateDBAASExternalEndpointRsyslogAndWait(ctx context.Context, endpointID UUID, req DBAASEndpointRsyslogInputUpdate) (*DBAASExternalEndpointRsyslogOutput, error) {
+	op, err := c.UpdateDBAASExternalEndpointRsyslog(ctx, endpointID, req)
 	if err != nil {
-		return nil, fmt.Errorf("UpdateDBAASExternalEndpointRsyslog: http client do: %w", err)
+		return nil, fmt.Errorf("UpdateDBAASExternalEndpointRsyslogAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASExternalEndpointRsyslog: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateDBAASExternalEndpointRsyslogAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASExternalEndpointRsyslog: prepare JSON response: %w", err)
+	resp, err := c.GetDBAASExternalEndpointRsyslog(ctx, endpointID)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateDBAASExternalEndpointRsyslogAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 // [BETA] Create RSyslog external integration endpoint
@@ -3878,30 +2918,9 @@ func (c Client) CreateDBAASExternalEndpointRsyslog(ctx context.Context, name str
 		return nil, fmt.Errorf("CreateDBAASExternalEndpointRsyslog: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASExternalEndpointRsyslog: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-external-endpoint-rsyslog")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("CreateDBAASExternalEndpointRsyslog: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateDBAASExternalEndpointRsyslog: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateDBAASExternalEndpointRsyslog: prepare JSON response: %w", err)
+	if err := c.do(ctx, "create-dbaas-external-endpoint-rsyslog", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASExternalEndpointRsyslog: %w", err)
 	}
 
 	return bodyresp, nil
@@ -3932,33 +2951,41 @@ func (c Client) ListDBAASExternalEndpointTypes(ctx context.Context) (*ListDBAASE
 		return nil, fmt.Errorf("ListDBAASExternalEndpointTypes: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListDBAASExternalEndpointTypes: sign request: %w", err)
+	bodyresp := new(ListDBAASExternalEndpointTypesResponse)
+	if err := c.do(ctx, "list-dbaas-external-endpoint-types", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ListDBAASExternalEndpointTypes: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "list-dbaas-external-endpoint-types")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ListDBAASExternalEndpointTypes: http client do: %w", err)
-	}
+// ListDBAASExternalEndpointTypesSeq returns an iterator over the elements listed by ListDBAASExternalEndpointTypes,
+// decoded while the response is read. The iteration stops at the first error,
+// yielded with a zero element, or once the context is done.
+func (c Client) ListDBAASExternalEndpointTypesSeq(ctx context.Context) iter.Seq2[ListDBAASExternalEndpointTypesResponseEndpointTypes, error] {
+	return func(yield func(ListDBAASExternalEndpointTypesResponseEndpointTypes, error) bool) {
+		path := "/dbaas-external-endpoint-types"
 
-	if c.trace {
-		dumpResponse(response)
-	}
+		request, err := http.NewRequestWithContext(ctx, "GET", c.serverEndpoint+path, nil)
+		if err != nil {
+			yieldError(yield, fmt.Errorf("ListDBAASExternalEndpointTypesSeq: new request: %w", err))
+			return
+		}
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ListDBAASExternalEndpointTypes: http response: %w", err)
-	}
+		request.Header.Add("User-Agent", c.getUserAgent())
 
-	bodyresp := new(ListDBAASExternalEndpointTypesResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ListDBAASExternalEndpointTypes: prepare JSON response: %w", err)
-	}
+		if err := c.executeRequestInterceptors(ctx, request); err != nil {
+			yieldError(yield, fmt.Errorf("ListDBAASExternalEndpointTypesSeq: execute request editors: %w", err))
+			return
+		}
 
-	return bodyresp, nil
+		bodyresp := new(ListDBAASExternalEndpointTypesResponse)
+		call := &Call{OperationID: "list-dbaas-external-endpoint-types", Request: request, Result: bodyresp}
+		elems := func() []ListDBAASExternalEndpointTypesResponseEndpointTypes { return bodyresp.EndpointTypes }
+		if err := streamList(ctx, c, call, true, "endpoint-types", elems, yield); err != nil {
+			yieldError(yield, fmt.Errorf("ListDBAASExternalEndpointTypesSeq: %w", err))
+		}
+	}
 }
 
 type AttachDBAASServiceToEndpointRequest struct {
@@ -3989,30 +3016,9 @@ func (c Client) AttachDBAASServiceToEndpoint(ctx context.Context, sourceServiceN
 		return nil, fmt.Errorf("AttachDBAASServiceToEndpoint: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("AttachDBAASServiceToEndpoint: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "attach-dbaas-service-to-endpoint")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("AttachDBAASServiceToEndpoint: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("AttachDBAASServiceToEndpoint: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("AttachDBAASServiceToEndpoint: prepare JSON response: %w", err)
+	if err := c.do(ctx, "attach-dbaas-service-to-endpoint", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("AttachDBAASServiceToEndpoint: %w", err)
 	}
 
 	return bodyresp, nil
@@ -4045,30 +3051,9 @@ func (c Client) DetachDBAASServiceFromEndpoint(ctx context.Context, sourceServic
 		return nil, fmt.Errorf("DetachDBAASServiceFromEndpoint: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DetachDBAASServiceFromEndpoint: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "detach-dbaas-service-from-endpoint")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DetachDBAASServiceFromEndpoint: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DetachDBAASServiceFromEndpoint: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DetachDBAASServiceFromEndpoint: prepare JSON response: %w", err)
+	if err := c.do(ctx, "detach-dbaas-service-from-endpoint", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DetachDBAASServiceFromEndpoint: %w", err)
 	}
 
 	return bodyresp, nil
@@ -4112,33 +3097,41 @@ func (c Client) ListDBAASExternalEndpoints(ctx context.Context) (*ListDBAASExter
 		return nil, fmt.Errorf("ListDBAASExternalEndpoints: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListDBAASExternalEndpoints: sign request: %w", err)
+	bodyresp := new(ListDBAASExternalEndpointsResponse)
+	if err := c.do(ctx, "list-dbaas-external-endpoints", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ListDBAASExternalEndpoints: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "list-dbaas-external-endpoints")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ListDBAASExternalEndpoints: http client do: %w", err)
-	}
+// ListDBAASExternalEndpointsSeq returns an iterator over the elements listed by ListDBAASExternalEndpoints,
+// decoded while the response is read. The iteration stops at the first error,
+// yielded with a zero element, or once the context is done.
+func (c Client) ListDBAASExternalEndpointsSeq(ctx context.Context) iter.Seq2[DBAASExternalEndpoint, error] {
+	return func(yield func(DBAASExternalEndpoint, error) bool) {
+		path := "/dbaas-external-endpoints"
 
-	if c.trace {
-		dumpResponse(response)
-	}
+		request, err := http.NewRequestWithContext(ctx, "GET", c.serverEndpoint+path, nil)
+		if err != nil {
+			yieldError(yield, fmt.Errorf("ListDBAASExternalEndpointsSeq: new request: %w", err))
+			return
+		}
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ListDBAASExternalEndpoints: http response: %w", err)
-	}
+		request.Header.Add("User-Agent", c.getUserAgent())
+
+		if err := c.executeRequestInterceptors(ctx, request); err != nil {
+			yieldError(yield, fmt.Errorf("ListDBAASExternalEndpointsSeq: execute request editors: %w", err))
+			return
+		}
 
-	bodyresp := new(ListDBAASExternalEndpointsResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ListDBAASExternalEndpoints: prepare JSON response: %w", err)
+		bodyresp := new(ListDBAASExternalEndpointsResponse)
+		call := &Call{OperationID: "list-dbaas-external-endpoints", Request: request, Result: bodyresp}
+		elems := func() []DBAASExternalEndpoint { return bodyresp.DBAASEndpoints }
+		if err := streamList(ctx, c, call, true, "dbaas-endpoints", elems, yield); err != nil {
+			yieldError(yield, fmt.Errorf("ListDBAASExternalEndpointsSeq: %w", err))
+		}
 	}
-
-	return bodyresp, nil
 }
 
 type GetDBAASExternalIntegrationSettingsDatadogResponse struct {
@@ -4160,30 +3153,9 @@ func (c Client) GetDBAASExternalIntegrationSettingsDatadog(ctx context.Context,
 		return nil, fmt.Errorf("GetDBAASExternalIntegrationSettingsDatadog: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASExternalIntegrationSettingsDatadog: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-external-integration-settings-datadog")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetDBAASExternalIntegrationSettingsDatadog: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetDBAASExternalIntegrationSettingsDatadog: http response: %w", err)
-	}
-
 	bodyresp := new(GetDBAASExternalIntegrationSettingsDatadogResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetDBAASExternalIntegrationSettingsDatadog: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-dbaas-external-integration-settings-datadog", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASExternalIntegrationSettingsDatadog: %w", err)
 	}
 
 	return bodyresp, nil
@@ -4215,30 +3187,9 @@ func (c Client) UpdateDBAASExternalIntegrationSettingsDatadog(ctx context.Contex
 		return nil, fmt.Errorf("UpdateDBAASExternalIntegrationSettingsDatadog: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASExternalIntegrationSettingsDatadog: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-dbaas-external-integration-settings-datadog")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("UpdateDBAASExternalIntegrationSettingsDatadog: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASExternalIntegrationSettingsDatadog: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASExternalIntegrationSettingsDatadog: prepare JSON response: %w", err)
+	if err := c.do(ctx, "update-dbaas-external-integration-settings-datadog", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASExternalIntegrationSettingsDatadog: %w", err)
 	}
 
 	return bodyresp, nil
@@ -4259,30 +3210,9 @@ func (c Client) GetDBAASExternalIntegration(ctx context.Context, integrationID U
 		return nil, fmt.Errorf("GetDBAASExternalIntegration: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASExternalIntegration: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-external-integration")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetDBAASExternalIntegration: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetDBAASExternalIntegration: http response: %w", err)
-	}
-
 	bodyresp := new(DBAASExternalIntegration)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetDBAASExternalIntegration: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-dbaas-external-integration", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASExternalIntegration: %w", err)
 	}
 
 	return bodyresp, nil
@@ -4307,33 +3237,41 @@ func (c Client) ListDBAASExternalIntegrations(ctx context.Context, serviceName s
 		return nil, fmt.Errorf("ListDBAASExternalIntegrations: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListDBAASExternalIntegrations: sign request: %w", err)
+	bodyresp := new(ListDBAASExternalIntegrationsResponse)
+	if err := c.do(ctx, "list-dbaas-external-integrations", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ListDBAASExternalIntegrations: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "list-dbaas-external-integrations")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ListDBAASExternalIntegrations: http client do: %w", err)
-	}
+// ListDBAASExternalIntegrationsSeq returns an iterator over the elements listed by ListDBAASExternalIntegrations,
+// decoded while the response is read. The iteration stops at the first error,
+// yielded with a zero element, or once the context is done.
+func (c Client) ListDBAASExternalIntegrationsSeq(ctx context.Context, serviceName string) iter.Seq2[DBAASExternalIntegration, error] {
+	return func(yield func(DBAASExternalIntegration, error) bool) {
+		path := fmt.Sprintf("/dbaas-external-integrations/%v", serviceName)
 
-	if c.trace {
-		dumpResponse(response)
-	}
+		request, err := http.NewRequestWithContext(ctx, "GET", c.serverEndpoint+path, nil)
+		if err != nil {
+			yieldError(yield, fmt.Errorf("ListDBAASExternalIntegrationsSeq: new request: %w", err))
+			return
+		}
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ListDBAASExternalIntegrations: http response: %w", err)
-	}
+		request.Header.Add("User-Agent", c.getUserAgent())
 
-	bodyresp := new(ListDBAASExternalIntegrationsResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ListDBAASExternalIntegrations: prepare JSON response: %w", err)
-	}
+		if err := c.executeRequestInterceptors(ctx, request); err != nil {
+			yieldError(yield, fmt.Errorf("ListDBAASExternalIntegrationsSeq: execute request editors: %w", err))
+			return
+		}
 
-	return bodyresp, nil
+		bodyresp := new(ListDBAASExternalIntegrationsResponse)
+		call := &Call{OperationID: "list-dbaas-external-integrations", Request: request, Result: bodyresp}
+		elems := func() []DBAASExternalIntegration { return bodyresp.ExternalIntegrations }
+		if err := streamList(ctx, c, call, true, "external-integrations", elems, yield); err != nil {
+			yieldError(yield, fmt.Errorf("ListDBAASExternalIntegrationsSeq: %w", err))
+		}
+	}
 }
 
 func (c Client) DeleteDBAASServiceGrafana(ctx context.Context, name string) (*Operation, error) {
@@ -4350,30 +3288,9 @@ func (c Client) DeleteDBAASServiceGrafana(ctx context.Context, name string) (*Op
 		return nil, fmt.Errorf("DeleteDBAASServiceGrafana: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASServiceGrafana: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-service-grafana")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteDBAASServiceGrafana: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASServiceGrafana: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASServiceGrafana: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-dbaas-service-grafana", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASServiceGrafana: %w", err)
 	}
 
 	return bodyresp, nil
@@ -4393,30 +3310,9 @@ func (c Client) GetDBAASServiceGrafana(ctx context.Context, name string) (*DBAAS
 		return nil, fmt.Errorf("GetDBAASServiceGrafana: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceGrafana: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-service-grafana")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceGrafana: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceGrafana: http response: %w", err)
-	}
-
 	bodyresp := new(DBAASServiceGrafana)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceGrafana: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-dbaas-service-grafana", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASServiceGrafana: %w", err)
 	}
 
 	return bodyresp, nil
@@ -4479,33 +3375,37 @@ func (c Client) CreateDBAASServiceGrafana(ctx context.Context, name string, req
 		return nil, fmt.Errorf("CreateDBAASServiceGrafana: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASServiceGrafana: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "create-dbaas-service-grafana", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASServiceGrafana: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "create-dbaas-service-grafana")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// CreateDBAASServiceGrafanaAndWait calls CreateDBAASServiceGrafana, waits for the operation to succeed
+// and returns the created resource.
+func (c Client) CreateDBAASServiceGrafanaAndWait(ctx context.Context, name string, req CreateDBAASServiceGrafanaRequest) (*DBAASServiceGrafana, error) {
+	op, err := c.CreateDBAASServiceGrafana(ctx, name, req)
 	if err != nil {
-		return nil, fmt.Errorf("CreateDBAASServiceGrafana: http client do: %w", err)
+		return nil, fmt.Errorf("CreateDBAASServiceGrafanaAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateDBAASServiceGrafana: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("CreateDBAASServiceGrafanaAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateDBAASServiceGrafana: prepare JSON response: %w", err)
+	resp, err := c.GetDBAASServiceGrafana(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("CreateDBAASServiceGrafanaAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 type UpdateDBAASServiceGrafanaRequestMaintenanceDow string
@@ -4563,33 +3463,37 @@ func (c Client) UpdateDBAASServiceGrafana(ctx context.Context, name string, req
 		return nil, fmt.Errorf("UpdateDBAASServiceGrafana: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASServiceGrafana: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "update-dbaas-service-grafana", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASServiceGrafana: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "update-dbaas-service-grafana")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// UpdateDBAASServiceGrafanaAndWait calls UpdateDBAASServiceGrafana, waits for the operation to succeed
+// and returns the updated resource.
+func (c Client) UpdateDBAASServiceGrafanaAndWait(ctx context.Context, name string, req UpdateDBAASServiceGrafanaRequest) (*DBAASServiceGrafana, error) {
+	op, err := c.UpdateDBAASServiceGrafana(ctx, name, req)
 	if err != nil {
-		return nil, fmt.Errorf("UpdateDBAASServiceGrafana: http client do: %w", err)
+		return nil, fmt.Errorf("UpdateDBAASServiceGrafanaAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASServiceGrafana: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateDBAASServiceGrafanaAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASServiceGrafana: prepare JSON response: %w", err)
+	resp, err := c.GetDBAASServiceGrafana(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateDBAASServiceGrafanaAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 func (c Client) StartDBAASGrafanaMaintenance(ctx context.Context, name string) (*Operation, error) {
@@ -4606,30 +3510,9 @@ func (c Client) StartDBAASGrafanaMaintenance(ctx context.Context, name string) (
 		return nil, fmt.Errorf("StartDBAASGrafanaMaintenance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("StartDBAASGrafanaMaintenance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "start-dbaas-grafana-maintenance")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("StartDBAASGrafanaMaintenance: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("StartDBAASGrafanaMaintenance: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("StartDBAASGrafanaMaintenance: prepare JSON response: %w", err)
+	if err := c.do(ctx, "start-dbaas-grafana-maintenance", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("StartDBAASGrafanaMaintenance: %w", err)
 	}
 
 	return bodyresp, nil
@@ -4661,30 +3544,9 @@ func (c Client) ResetDBAASGrafanaUserPassword(ctx context.Context, serviceName s
 		return nil, fmt.Errorf("ResetDBAASGrafanaUserPassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetDBAASGrafanaUserPassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-dbaas-grafana-user-password")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ResetDBAASGrafanaUserPassword: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ResetDBAASGrafanaUserPassword: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ResetDBAASGrafanaUserPassword: prepare JSON response: %w", err)
+	if err := c.do(ctx, "reset-dbaas-grafana-user-password", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ResetDBAASGrafanaUserPassword: %w", err)
 	}
 
 	return bodyresp, nil
@@ -4704,30 +3566,9 @@ func (c Client) RevealDBAASGrafanaUserPassword(ctx context.Context, serviceName
 		return nil, fmt.Errorf("RevealDBAASGrafanaUserPassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RevealDBAASGrafanaUserPassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reveal-dbaas-grafana-user-password")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("RevealDBAASGrafanaUserPassword: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("RevealDBAASGrafanaUserPassword: http response: %w", err)
-	}
-
 	bodyresp := new(DBAASUserGrafanaSecrets)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("RevealDBAASGrafanaUserPassword: prepare JSON response: %w", err)
+	if err := c.do(ctx, "reveal-dbaas-grafana-user-password", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("RevealDBAASGrafanaUserPassword: %w", err)
 	}
 
 	return bodyresp, nil
@@ -4763,33 +3604,40 @@ func (c Client) CreateDBAASIntegration(ctx context.Context, req CreateDBAASInteg
 		return nil, fmt.Errorf("CreateDBAASIntegration: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASIntegration: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "create-dbaas-integration", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASIntegration: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "create-dbaas-integration")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// CreateDBAASIntegrationAndWait calls CreateDBAASIntegration, waits for the operation to succeed
+// and returns the created resource.
+func (c Client) CreateDBAASIntegrationAndWait(ctx context.Context, req CreateDBAASIntegrationRequest) (*DBAASIntegration, error) {
+	op, err := c.CreateDBAASIntegration(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("CreateDBAASIntegration: http client do: %w", err)
+		return nil, fmt.Errorf("CreateDBAASIntegrationAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateDBAASIntegration: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("CreateDBAASIntegrationAndWait: %w", &OperationError{ID: op.ID, Err: err})
+	}
+	if done.Reference == nil {
+		return nil, fmt.Errorf("CreateDBAASIntegrationAndWait: %w", &OperationError{ID: op.ID, Err: ErrMissingOperationReference})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateDBAASIntegration: prepare JSON response: %w", err)
+	resp, err := c.GetDBAASIntegration(ctx, done.Reference.ID)
+	if err != nil {
+		return nil, fmt.Errorf("CreateDBAASIntegrationAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 // The JSON schema representing the settings for the given integration type, source, and destination service types.
@@ -4820,30 +3668,9 @@ func (c Client) ListDBAASIntegrationSettings(ctx context.Context, integrationTyp
 		return nil, fmt.Errorf("ListDBAASIntegrationSettings: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListDBAASIntegrationSettings: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-dbaas-integration-settings")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ListDBAASIntegrationSettings: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ListDBAASIntegrationSettings: http response: %w", err)
-	}
-
 	bodyresp := new(ListDBAASIntegrationSettingsResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ListDBAASIntegrationSettings: prepare JSON response: %w", err)
+	if err := c.do(ctx, "list-dbaas-integration-settings", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ListDBAASIntegrationSettings: %w", err)
 	}
 
 	return bodyresp, nil
@@ -4868,33 +3695,41 @@ func (c Client) ListDBAASIntegrationTypes(ctx context.Context) (*ListDBAASIntegr
 		return nil, fmt.Errorf("ListDBAASIntegrationTypes: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListDBAASIntegrationTypes: sign request: %w", err)
+	bodyresp := new(ListDBAASIntegrationTypesResponse)
+	if err := c.do(ctx, "list-dbaas-integration-types", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ListDBAASIntegrationTypes: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "list-dbaas-integration-types")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ListDBAASIntegrationTypes: http client do: %w", err)
-	}
+// ListDBAASIntegrationTypesSeq returns an iterator over the elements listed by ListDBAASIntegrationTypes,
+// decoded while the response is read. The iteration stops at the first error,
+// yielded with a zero element, or once the context is done.
+func (c Client) ListDBAASIntegrationTypesSeq(ctx context.Context) iter.Seq2[DBAASIntegrationType, error] {
+	return func(yield func(DBAASIntegrationType, error) bool) {
+		path := "/dbaas-integration-types"
 
-	if c.trace {
-		dumpResponse(response)
-	}
+		request, err := http.NewRequestWithContext(ctx, "GET", c.serverEndpoint+path, nil)
+		if err != nil {
+			yieldError(yield, fmt.Errorf("ListDBAASIntegrationTypesSeq: new request: %w", err))
+			return
+		}
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ListDBAASIntegrationTypes: http response: %w", err)
-	}
+		request.Header.Add("User-Agent", c.getUserAgent())
 
-	bodyresp := new(ListDBAASIntegrationTypesResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ListDBAASIntegrationTypes: prepare JSON response: %w", err)
-	}
+		if err := c.executeRequestInterceptors(ctx, request); err != nil {
+			yieldError(yield, fmt.Errorf("ListDBAASIntegrationTypesSeq: execute request editors: %w", err))
+			return
+		}
 
-	return bodyresp, nil
+		bodyresp := new(ListDBAASIntegrationTypesResponse)
+		call := &Call{OperationID: "list-dbaas-integration-types", Request: request, Result: bodyresp}
+		elems := func() []DBAASIntegrationType { return bodyresp.DBAASIntegrationTypes }
+		if err := streamList(ctx, c, call, true, "dbaas-integration-types", elems, yield); err != nil {
+			yieldError(yield, fmt.Errorf("ListDBAASIntegrationTypesSeq: %w", err))
+		}
+	}
 }
 
 // [BETA] Delete a DBaaS Integration
@@ -4912,30 +3747,9 @@ func (c Client) DeleteDBAASIntegration(ctx context.Context, id UUID) (*Operation
 		return nil, fmt.Errorf("DeleteDBAASIntegration: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASIntegration: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-integration")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteDBAASIntegration: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASIntegration: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASIntegration: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-dbaas-integration", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASIntegration: %w", err)
 	}
 
 	return bodyresp, nil
@@ -4956,30 +3770,9 @@ func (c Client) GetDBAASIntegration(ctx context.Context, id UUID) (*DBAASIntegra
 		return nil, fmt.Errorf("GetDBAASIntegration: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASIntegration: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-integration")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetDBAASIntegration: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetDBAASIntegration: http response: %w", err)
-	}
-
 	bodyresp := new(DBAASIntegration)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetDBAASIntegration: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-dbaas-integration", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASIntegration: %w", err)
 	}
 
 	return bodyresp, nil
@@ -5012,33 +3805,37 @@ func (c Client) UpdateDBAASIntegration(ctx context.Context, id UUID, req UpdateD
 		return nil, fmt.Errorf("UpdateDBAASIntegration: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASIntegration: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "update-dbaas-integration", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASIntegration: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "update-dbaas-integration")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// UpdateDBAASIntegrationAndWait calls UpdateDBAASIntegration, waits for the operation to succeed
+// and returns the updated resource.
+func (c Client) UpdateDBAASIntegrationAndWait(ctx context.Context, id UUID, req UpdateDBAASIntegrationRequest) (*DBAASIntegration, error) {
+	op, err := c.UpdateDBAASIntegration(ctx, id, req)
 	if err != nil {
-		return nil, fmt.Errorf("UpdateDBAASIntegration: http client do: %w", err)
+		return nil, fmt.Errorf("UpdateDBAASIntegrationAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASIntegration: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateDBAASIntegrationAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASIntegration: prepare JSON response: %w", err)
+	resp, err := c.GetDBAASIntegration(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateDBAASIntegrationAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 func (c Client) DeleteDBAASServiceKafka(ctx context.Context, name string) (*Operation, error) {
@@ -5055,30 +3852,9 @@ func (c Client) DeleteDBAASServiceKafka(ctx context.Context, name string) (*Oper
 		return nil, fmt.Errorf("DeleteDBAASServiceKafka: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASServiceKafka: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-service-kafka")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteDBAASServiceKafka: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASServiceKafka: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASServiceKafka: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-dbaas-service-kafka", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASServiceKafka: %w", err)
 	}
 
 	return bodyresp, nil
@@ -5098,30 +3874,9 @@ func (c Client) GetDBAASServiceKafka(ctx context.Context, name string) (*DBAASSe
 		return nil, fmt.Errorf("GetDBAASServiceKafka: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceKafka: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-service-kafka")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceKafka: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceKafka: http response: %w", err)
-	}
-
 	bodyresp := new(DBAASServiceKafka)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceKafka: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-dbaas-service-kafka", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASServiceKafka: %w", err)
 	}
 
 	return bodyresp, nil
@@ -5207,33 +3962,37 @@ func (c Client) CreateDBAASServiceKafka(ctx context.Context, name string, req Cr
 		return nil, fmt.Errorf("CreateDBAASServiceKafka: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASServiceKafka: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "create-dbaas-service-kafka", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASServiceKafka: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "create-dbaas-service-kafka")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// CreateDBAASServiceKafkaAndWait calls CreateDBAASServiceKafka, waits for the operation to succeed
+// and returns the created resource.
+func (c Client) CreateDBAASServiceKafkaAndWait(ctx context.Context, name string, req CreateDBAASServiceKafkaRequest) (*DBAASServiceKafka, error) {
+	op, err := c.CreateDBAASServiceKafka(ctx, name, req)
 	if err != nil {
-		return nil, fmt.Errorf("CreateDBAASServiceKafka: http client do: %w", err)
+		return nil, fmt.Errorf("CreateDBAASServiceKafkaAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateDBAASServiceKafka: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("CreateDBAASServiceKafkaAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateDBAASServiceKafka: prepare JSON response: %w", err)
+	resp, err := c.GetDBAASServiceKafka(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("CreateDBAASServiceKafkaAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 // Kafka authentication methods
@@ -5316,33 +4075,37 @@ func (c Client) UpdateDBAASServiceKafka(ctx context.Context, name string, req Up
 		return nil, fmt.Errorf("UpdateDBAASServiceKafka: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASServiceKafka: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "update-dbaas-service-kafka", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASServiceKafka: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "update-dbaas-service-kafka")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// UpdateDBAASServiceKafkaAndWait calls UpdateDBAASServiceKafka, waits for the operation to succeed
+// and returns the updated resource.
+func (c Client) UpdateDBAASServiceKafkaAndWait(ctx context.Context, name string, req UpdateDBAASServiceKafkaRequest) (*DBAASServiceKafka, error) {
+	op, err := c.UpdateDBAASServiceKafka(ctx, name, req)
 	if err != nil {
-		return nil, fmt.Errorf("UpdateDBAASServiceKafka: http client do: %w", err)
+		return nil, fmt.Errorf("UpdateDBAASServiceKafkaAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASServiceKafka: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateDBAASServiceKafkaAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASServiceKafka: prepare JSON response: %w", err)
+	resp, err := c.GetDBAASServiceKafka(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateDBAASServiceKafkaAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 func (c Client) GetDBAASKafkaAclConfig(ctx context.Context, name string) (*DBAASKafkaAcls, error) {
@@ -5359,30 +4122,9 @@ func (c Client) GetDBAASKafkaAclConfig(ctx context.Context, name string) (*DBAAS
 		return nil, fmt.Errorf("GetDBAASKafkaAclConfig: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASKafkaAclConfig: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-kafka-acl-config")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetDBAASKafkaAclConfig: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetDBAASKafkaAclConfig: http response: %w", err)
-	}
-
 	bodyresp := new(DBAASKafkaAcls)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetDBAASKafkaAclConfig: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-dbaas-kafka-acl-config", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASKafkaAclConfig: %w", err)
 	}
 
 	return bodyresp, nil
@@ -5398,34 +4140,13 @@ func (c Client) StartDBAASKafkaMaintenance(ctx context.Context, name string) (*O
 
 	request.Header.Add("User-Agent", c.getUserAgent())
 
-	if err := c.executeRequestInterceptors(ctx, request); err != nil {
-		return nil, fmt.Errorf("StartDBAASKafkaMaintenance: execute request editors: %w", err)
-	}
-
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("StartDBAASKafkaMaintenance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "start-dbaas-kafka-maintenance")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("StartDBAASKafkaMaintenance: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("StartDBAASKafkaMaintenance: http response: %w", err)
+	if err := c.executeRequestInterceptors(ctx, request); err != nil {
+		return nil, fmt.Errorf("StartDBAASKafkaMaintenance: execute request editors: %w", err)
 	}
 
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("StartDBAASKafkaMaintenance: prepare JSON response: %w", err)
+	if err := c.do(ctx, "start-dbaas-kafka-maintenance", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("StartDBAASKafkaMaintenance: %w", err)
 	}
 
 	return bodyresp, nil
@@ -5452,30 +4173,9 @@ func (c Client) CreateDBAASKafkaSchemaRegistryAclConfig(ctx context.Context, nam
 		return nil, fmt.Errorf("CreateDBAASKafkaSchemaRegistryAclConfig: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASKafkaSchemaRegistryAclConfig: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-kafka-schema-registry-acl-config")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("CreateDBAASKafkaSchemaRegistryAclConfig: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateDBAASKafkaSchemaRegistryAclConfig: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateDBAASKafkaSchemaRegistryAclConfig: prepare JSON response: %w", err)
+	if err := c.do(ctx, "create-dbaas-kafka-schema-registry-acl-config", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASKafkaSchemaRegistryAclConfig: %w", err)
 	}
 
 	return bodyresp, nil
@@ -5495,30 +4195,9 @@ func (c Client) DeleteDBAASKafkaSchemaRegistryAclConfig(ctx context.Context, nam
 		return nil, fmt.Errorf("DeleteDBAASKafkaSchemaRegistryAclConfig: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASKafkaSchemaRegistryAclConfig: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-kafka-schema-registry-acl-config")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteDBAASKafkaSchemaRegistryAclConfig: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASKafkaSchemaRegistryAclConfig: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASKafkaSchemaRegistryAclConfig: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-dbaas-kafka-schema-registry-acl-config", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASKafkaSchemaRegistryAclConfig: %w", err)
 	}
 
 	return bodyresp, nil
@@ -5545,30 +4224,9 @@ func (c Client) CreateDBAASKafkaTopicAclConfig(ctx context.Context, name string,
 		return nil, fmt.Errorf("CreateDBAASKafkaTopicAclConfig: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASKafkaTopicAclConfig: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-kafka-topic-acl-config")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("CreateDBAASKafkaTopicAclConfig: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateDBAASKafkaTopicAclConfig: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateDBAASKafkaTopicAclConfig: prepare JSON response: %w", err)
+	if err := c.do(ctx, "create-dbaas-kafka-topic-acl-config", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASKafkaTopicAclConfig: %w", err)
 	}
 
 	return bodyresp, nil
@@ -5588,30 +4246,9 @@ func (c Client) DeleteDBAASKafkaTopicAclConfig(ctx context.Context, name string,
 		return nil, fmt.Errorf("DeleteDBAASKafkaTopicAclConfig: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASKafkaTopicAclConfig: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-kafka-topic-acl-config")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteDBAASKafkaTopicAclConfig: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASKafkaTopicAclConfig: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASKafkaTopicAclConfig: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-dbaas-kafka-topic-acl-config", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASKafkaTopicAclConfig: %w", err)
 	}
 
 	return bodyresp, nil
@@ -5631,30 +4268,9 @@ func (c Client) RevealDBAASKafkaConnectPassword(ctx context.Context, serviceName
 		return nil, fmt.Errorf("RevealDBAASKafkaConnectPassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RevealDBAASKafkaConnectPassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reveal-dbaas-kafka-connect-password")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("RevealDBAASKafkaConnectPassword: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("RevealDBAASKafkaConnectPassword: http response: %w", err)
-	}
-
 	bodyresp := new(DBAASUserKafkaConnectSecrets)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("RevealDBAASKafkaConnectPassword: prepare JSON response: %w", err)
+	if err := c.do(ctx, "reveal-dbaas-kafka-connect-password", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("RevealDBAASKafkaConnectPassword: %w", err)
 	}
 
 	return bodyresp, nil
@@ -5685,30 +4301,9 @@ func (c Client) CreateDBAASKafkaUser(ctx context.Context, serviceName string, re
 		return nil, fmt.Errorf("CreateDBAASKafkaUser: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASKafkaUser: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-kafka-user")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("CreateDBAASKafkaUser: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateDBAASKafkaUser: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateDBAASKafkaUser: prepare JSON response: %w", err)
+	if err := c.do(ctx, "create-dbaas-kafka-user", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASKafkaUser: %w", err)
 	}
 
 	return bodyresp, nil
@@ -5728,30 +4323,9 @@ func (c Client) DeleteDBAASKafkaUser(ctx context.Context, serviceName string, us
 		return nil, fmt.Errorf("DeleteDBAASKafkaUser: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASKafkaUser: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-kafka-user")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteDBAASKafkaUser: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASKafkaUser: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASKafkaUser: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-dbaas-kafka-user", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASKafkaUser: %w", err)
 	}
 
 	return bodyresp, nil
@@ -5783,30 +4357,9 @@ func (c Client) ResetDBAASKafkaUserPassword(ctx context.Context, serviceName str
 		return nil, fmt.Errorf("ResetDBAASKafkaUserPassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetDBAASKafkaUserPassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-dbaas-kafka-user-password")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ResetDBAASKafkaUserPassword: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ResetDBAASKafkaUserPassword: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ResetDBAASKafkaUserPassword: prepare JSON response: %w", err)
+	if err := c.do(ctx, "reset-dbaas-kafka-user-password", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ResetDBAASKafkaUserPassword: %w", err)
 	}
 
 	return bodyresp, nil
@@ -5826,30 +4379,9 @@ func (c Client) RevealDBAASKafkaUserPassword(ctx context.Context, serviceName st
 		return nil, fmt.Errorf("RevealDBAASKafkaUserPassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RevealDBAASKafkaUserPassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reveal-dbaas-kafka-user-password")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("RevealDBAASKafkaUserPassword: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("RevealDBAASKafkaUserPassword: http response: %w", err)
-	}
-
 	bodyresp := new(DBAASUserKafkaSecrets)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("RevealDBAASKafkaUserPassword: prepare JSON response: %w", err)
+	if err := c.do(ctx, "reveal-dbaas-kafka-user-password", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("RevealDBAASKafkaUserPassword: %w", err)
 	}
 
 	return bodyresp, nil
@@ -5870,30 +4402,9 @@ func (c Client) GetDBAASMigrationStatus(ctx context.Context, name string) (*DBAA
 		return nil, fmt.Errorf("GetDBAASMigrationStatus: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASMigrationStatus: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-migration-status")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetDBAASMigrationStatus: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetDBAASMigrationStatus: http response: %w", err)
-	}
-
 	bodyresp := new(DBAASMigrationStatus)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetDBAASMigrationStatus: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-dbaas-migration-status", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASMigrationStatus: %w", err)
 	}
 
 	return bodyresp, nil
@@ -5913,30 +4424,9 @@ func (c Client) DeleteDBAASServiceMysql(ctx context.Context, name string) (*Oper
 		return nil, fmt.Errorf("DeleteDBAASServiceMysql: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASServiceMysql: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-service-mysql")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteDBAASServiceMysql: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASServiceMysql: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASServiceMysql: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-dbaas-service-mysql", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASServiceMysql: %w", err)
 	}
 
 	return bodyresp, nil
@@ -5957,30 +4447,9 @@ func (c Client) GetDBAASServiceMysql(ctx context.Context, name string) (*DBAASSe
 		return nil, fmt.Errorf("GetDBAASServiceMysql: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceMysql: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-service-mysql")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceMysql: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceMysql: http response: %w", err)
-	}
-
 	bodyresp := new(DBAASServiceMysql)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceMysql: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-dbaas-service-mysql", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASServiceMysql: %w", err)
 	}
 
 	return bodyresp, nil
@@ -6098,33 +4567,37 @@ func (c Client) CreateDBAASServiceMysql(ctx context.Context, name string, req Cr
 		return nil, fmt.Errorf("CreateDBAASServiceMysql: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASServiceMysql: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "create-dbaas-service-mysql", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASServiceMysql: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "create-dbaas-service-mysql")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// CreateDBAASServiceMysqlAndWait calls CreateDBAASServiceMysql, waits for the operation to succeed
+// and returns the created resource.
+func (c Client) CreateDBAASServiceMysqlAndWait(ctx context.Context, name string, req CreateDBAASServiceMysqlRequest) (*DBAASServiceMysql, error) {
+	op, err := c.CreateDBAASServiceMysql(ctx, name, req)
 	if err != nil {
-		return nil, fmt.Errorf("CreateDBAASServiceMysql: http client do: %w", err)
+		return nil, fmt.Errorf("CreateDBAASServiceMysqlAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateDBAASServiceMysql: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("CreateDBAASServiceMysqlAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateDBAASServiceMysql: prepare JSON response: %w", err)
+	resp, err := c.GetDBAASServiceMysql(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("CreateDBAASServiceMysqlAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 type UpdateDBAASServiceMysqlRequestBackupSchedule struct {
@@ -6216,33 +4689,37 @@ func (c Client) UpdateDBAASServiceMysql(ctx context.Context, name string, req Up
 		return nil, fmt.Errorf("UpdateDBAASServiceMysql: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASServiceMysql: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "update-dbaas-service-mysql", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASServiceMysql: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "update-dbaas-service-mysql")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// UpdateDBAASServiceMysqlAndWait calls UpdateDBAASServiceMysql, waits for the operation to succeed
+// and returns the updated resource.
+func (c Client) UpdateDBAASServiceMysqlAndWait(ctx context.Context, name string, req UpdateDBAASServiceMysqlRequest) (*DBAASServiceMysql, error) {
+	op, err := c.UpdateDBAASServiceMysql(ctx, name, req)
 	if err != nil {
-		return nil, fmt.Errorf("UpdateDBAASServiceMysql: http client do: %w", err)
+		return nil, fmt.Errorf("UpdateDBAASServiceMysqlAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASServiceMysql: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateDBAASServiceMysqlAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASServiceMysql: prepare JSON response: %w", err)
+	resp, err := c.GetDBAASServiceMysql(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateDBAASServiceMysqlAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 func (c Client) EnableDBAASMysqlWrites(ctx context.Context, name string) (*Operation, error) {
@@ -6259,30 +4736,9 @@ func (c Client) EnableDBAASMysqlWrites(ctx context.Context, name string) (*Opera
 		return nil, fmt.Errorf("EnableDBAASMysqlWrites: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("EnableDBAASMysqlWrites: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "enable-dbaas-mysql-writes")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("EnableDBAASMysqlWrites: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("EnableDBAASMysqlWrites: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("EnableDBAASMysqlWrites: prepare JSON response: %w", err)
+	if err := c.do(ctx, "enable-dbaas-mysql-writes", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("EnableDBAASMysqlWrites: %w", err)
 	}
 
 	return bodyresp, nil
@@ -6302,30 +4758,9 @@ func (c Client) StartDBAASMysqlMaintenance(ctx context.Context, name string) (*O
 		return nil, fmt.Errorf("StartDBAASMysqlMaintenance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("StartDBAASMysqlMaintenance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "start-dbaas-mysql-maintenance")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("StartDBAASMysqlMaintenance: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("StartDBAASMysqlMaintenance: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("StartDBAASMysqlMaintenance: prepare JSON response: %w", err)
+	if err := c.do(ctx, "start-dbaas-mysql-maintenance", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("StartDBAASMysqlMaintenance: %w", err)
 	}
 
 	return bodyresp, nil
@@ -6345,30 +4780,9 @@ func (c Client) StopDBAASMysqlMigration(ctx context.Context, name string) (*Oper
 		return nil, fmt.Errorf("StopDBAASMysqlMigration: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("StopDBAASMysqlMigration: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "stop-dbaas-mysql-migration")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("StopDBAASMysqlMigration: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("StopDBAASMysqlMigration: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("StopDBAASMysqlMigration: prepare JSON response: %w", err)
+	if err := c.do(ctx, "stop-dbaas-mysql-migration", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("StopDBAASMysqlMigration: %w", err)
 	}
 
 	return bodyresp, nil
@@ -6399,30 +4813,9 @@ func (c Client) CreateDBAASMysqlDatabase(ctx context.Context, serviceName string
 		return nil, fmt.Errorf("CreateDBAASMysqlDatabase: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASMysqlDatabase: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-mysql-database")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("CreateDBAASMysqlDatabase: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateDBAASMysqlDatabase: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateDBAASMysqlDatabase: prepare JSON response: %w", err)
+	if err := c.do(ctx, "create-dbaas-mysql-database", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASMysqlDatabase: %w", err)
 	}
 
 	return bodyresp, nil
@@ -6442,30 +4835,9 @@ func (c Client) DeleteDBAASMysqlDatabase(ctx context.Context, serviceName string
 		return nil, fmt.Errorf("DeleteDBAASMysqlDatabase: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASMysqlDatabase: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-mysql-database")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteDBAASMysqlDatabase: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASMysqlDatabase: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASMysqlDatabase: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-dbaas-mysql-database", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASMysqlDatabase: %w", err)
 	}
 
 	return bodyresp, nil
@@ -6497,30 +4869,9 @@ func (c Client) CreateDBAASMysqlUser(ctx context.Context, serviceName string, re
 		return nil, fmt.Errorf("CreateDBAASMysqlUser: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASMysqlUser: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-mysql-user")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("CreateDBAASMysqlUser: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateDBAASMysqlUser: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateDBAASMysqlUser: prepare JSON response: %w", err)
+	if err := c.do(ctx, "create-dbaas-mysql-user", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASMysqlUser: %w", err)
 	}
 
 	return bodyresp, nil
@@ -6540,30 +4891,9 @@ func (c Client) DeleteDBAASMysqlUser(ctx context.Context, serviceName string, us
 		return nil, fmt.Errorf("DeleteDBAASMysqlUser: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASMysqlUser: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-mysql-user")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteDBAASMysqlUser: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASMysqlUser: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASMysqlUser: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-dbaas-mysql-user", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASMysqlUser: %w", err)
 	}
 
 	return bodyresp, nil
@@ -6596,73 +4926,31 @@ func (c Client) ResetDBAASMysqlUserPassword(ctx context.Context, serviceName str
 		return nil, fmt.Errorf("ResetDBAASMysqlUserPassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetDBAASMysqlUserPassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-dbaas-mysql-user-password")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ResetDBAASMysqlUserPassword: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ResetDBAASMysqlUserPassword: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ResetDBAASMysqlUserPassword: prepare JSON response: %w", err)
+	if err := c.do(ctx, "reset-dbaas-mysql-user-password", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ResetDBAASMysqlUserPassword: %w", err)
 	}
 
 	return bodyresp, nil
 }
 
-func (c Client) RevealDBAASMysqlUserPassword(ctx context.Context, serviceName string, username string) (*DBAASUserMysqlSecrets, error) {
-	path := fmt.Sprintf("/dbaas-mysql/%v/user/%v/password/reveal", serviceName, username)
-
-	request, err := http.NewRequestWithContext(ctx, "GET", c.serverEndpoint+path, nil)
-	if err != nil {
-		return nil, fmt.Errorf("RevealDBAASMysqlUserPassword: new request: %w", err)
-	}
-
-	request.Header.Add("User-Agent", c.getUserAgent())
-
-	if err := c.executeRequestInterceptors(ctx, request); err != nil {
-		return nil, fmt.Errorf("RevealDBAASMysqlUserPassword: execute request editors: %w", err)
-	}
-
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RevealDBAASMysqlUserPassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reveal-dbaas-mysql-user-password")
-	}
+func (c Client) RevealDBAASMysqlUserPassword(ctx context.Context, serviceName string, username string) (*DBAASUserMysqlSecrets, error) {
+	path := fmt.Sprintf("/dbaas-mysql/%v/user/%v/password/reveal", serviceName, username)
 
-	response, err := c.httpClient.Do(request)
+	request, err := http.NewRequestWithContext(ctx, "GET", c.serverEndpoint+path, nil)
 	if err != nil {
-		return nil, fmt.Errorf("RevealDBAASMysqlUserPassword: http client do: %w", err)
+		return nil, fmt.Errorf("RevealDBAASMysqlUserPassword: new request: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
+	request.Header.Add("User-Agent", c.getUserAgent())
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("RevealDBAASMysqlUserPassword: http response: %w", err)
+	if err := c.executeRequestInterceptors(ctx, request); err != nil {
+		return nil, fmt.Errorf("RevealDBAASMysqlUserPassword: execute request editors: %w", err)
 	}
 
 	bodyresp := new(DBAASUserMysqlSecrets)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("RevealDBAASMysqlUserPassword: prepare JSON response: %w", err)
+	if err := c.do(ctx, "reveal-dbaas-mysql-user-password", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("RevealDBAASMysqlUserPassword: %w", err)
 	}
 
 	return bodyresp, nil
@@ -6682,30 +4970,9 @@ func (c Client) DeleteDBAASServiceOpensearch(ctx context.Context, name string) (
 		return nil, fmt.Errorf("DeleteDBAASServiceOpensearch: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASServiceOpensearch: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-service-opensearch")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteDBAASServiceOpensearch: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASServiceOpensearch: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASServiceOpensearch: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-dbaas-service-opensearch", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASServiceOpensearch: %w", err)
 	}
 
 	return bodyresp, nil
@@ -6726,30 +4993,9 @@ func (c Client) GetDBAASServiceOpensearch(ctx context.Context, name string) (*DB
 		return nil, fmt.Errorf("GetDBAASServiceOpensearch: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceOpensearch: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-service-opensearch")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceOpensearch: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceOpensearch: http response: %w", err)
-	}
-
 	bodyresp := new(DBAASServiceOpensearch)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceOpensearch: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-dbaas-service-opensearch", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASServiceOpensearch: %w", err)
 	}
 
 	return bodyresp, nil
@@ -6862,33 +5108,37 @@ func (c Client) CreateDBAASServiceOpensearch(ctx context.Context, name string, r
 		return nil, fmt.Errorf("CreateDBAASServiceOpensearch: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASServiceOpensearch: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "create-dbaas-service-opensearch", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASServiceOpensearch: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "create-dbaas-service-opensearch")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// CreateDBAASServiceOpensearchAndWait calls CreateDBAASServiceOpensearch, waits for the operation to succeed
+// and returns the created resource.
+func (c Client) CreateDBAASServiceOpensearchAndWait(ctx context.Context, name string, req CreateDBAASServiceOpensearchRequest) (*DBAASServiceOpensearch, error) {
+	op, err := c.CreateDBAASServiceOpensearch(ctx, name, req)
 	if err != nil {
-		return nil, fmt.Errorf("CreateDBAASServiceOpensearch: http client do: %w", err)
+		return nil, fmt.Errorf("CreateDBAASServiceOpensearchAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateDBAASServiceOpensearch: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("CreateDBAASServiceOpensearchAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateDBAASServiceOpensearch: prepare JSON response: %w", err)
+	resp, err := c.GetDBAASServiceOpensearch(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("CreateDBAASServiceOpensearchAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 type UpdateDBAASServiceOpensearchRequestIndexPatternsSortingAlgorithm string
@@ -6995,33 +5245,37 @@ func (c Client) UpdateDBAASServiceOpensearch(ctx context.Context, name string, r
 		return nil, fmt.Errorf("UpdateDBAASServiceOpensearch: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASServiceOpensearch: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "update-dbaas-service-opensearch", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASServiceOpensearch: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "update-dbaas-service-opensearch")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// UpdateDBAASServiceOpensearchAndWait calls UpdateDBAASServiceOpensearch, waits for the operation to succeed
+// and returns the updated resource.
+func (c Client) UpdateDBAASServiceOpensearchAndWait(ctx context.Context, name string, req UpdateDBAASServiceOpensearchRequest) (*DBAASServiceOpensearch, error) {
+	op, err := c.UpdateDBAASServiceOpensearch(ctx, name, req)
 	if err != nil {
-		return nil, fmt.Errorf("UpdateDBAASServiceOpensearch: http client do: %w", err)
+		return nil, fmt.Errorf("UpdateDBAASServiceOpensearchAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASServiceOpensearch: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateDBAASServiceOpensearchAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASServiceOpensearch: prepare JSON response: %w", err)
+	resp, err := c.GetDBAASServiceOpensearch(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateDBAASServiceOpensearchAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 func (c Client) GetDBAASOpensearchAclConfig(ctx context.Context, name string) (*DBAASOpensearchAclConfig, error) {
@@ -7038,30 +5292,9 @@ func (c Client) GetDBAASOpensearchAclConfig(ctx context.Context, name string) (*
 		return nil, fmt.Errorf("GetDBAASOpensearchAclConfig: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASOpensearchAclConfig: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-opensearch-acl-config")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetDBAASOpensearchAclConfig: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetDBAASOpensearchAclConfig: http response: %w", err)
-	}
-
 	bodyresp := new(DBAASOpensearchAclConfig)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetDBAASOpensearchAclConfig: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-dbaas-opensearch-acl-config", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASOpensearchAclConfig: %w", err)
 	}
 
 	return bodyresp, nil
@@ -7088,33 +5321,37 @@ func (c Client) UpdateDBAASOpensearchAclConfig(ctx context.Context, name string,
 		return nil, fmt.Errorf("UpdateDBAASOpensearchAclConfig: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASOpensearchAclConfig: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "update-dbaas-opensearch-acl-config", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASOpensearchAclConfig: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "update-dbaas-opensearch-acl-config")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// UpdateDBAASOpensearchAclConfigAndWait calls UpdateDBAASOpensearchAclConfig, waits for the operation to succeed
+// and returns the updated resource.
+func (c Client) UpdateDBAASOpensearchAclConfigAndWait(ctx context.Context, name string, req DBAASOpensearchAclConfig) (*DBAASOpensearchAclConfig, error) {
+	op, err := c.UpdateDBAASOpensearchAclConfig(ctx, name, req)
 	if err != nil {
-		return nil, fmt.Errorf("UpdateDBAASOpensearchAclConfig: http client do: %w", err)
+		return nil, fmt.Errorf("UpdateDBAASOpensearchAclConfigAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASOpensearchAclConfig: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateDBAASOpensearchAclConfigAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASOpensearchAclConfig: prepare JSON response: %w", err)
+	resp, err := c.GetDBAASOpensearchAclConfig(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateDBAASOpensearchAclConfigAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 func (c Client) StartDBAASOpensearchMaintenance(ctx context.Context, name string) (*Operation, error) {
@@ -7131,30 +5368,9 @@ func (c Client) StartDBAASOpensearchMaintenance(ctx context.Context, name string
 		return nil, fmt.Errorf("StartDBAASOpensearchMaintenance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("StartDBAASOpensearchMaintenance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "start-dbaas-opensearch-maintenance")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("StartDBAASOpensearchMaintenance: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("StartDBAASOpensearchMaintenance: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("StartDBAASOpensearchMaintenance: prepare JSON response: %w", err)
+	if err := c.do(ctx, "start-dbaas-opensearch-maintenance", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("StartDBAASOpensearchMaintenance: %w", err)
 	}
 
 	return bodyresp, nil
@@ -7185,30 +5401,9 @@ func (c Client) CreateDBAASOpensearchUser(ctx context.Context, serviceName strin
 		return nil, fmt.Errorf("CreateDBAASOpensearchUser: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASOpensearchUser: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-opensearch-user")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("CreateDBAASOpensearchUser: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateDBAASOpensearchUser: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateDBAASOpensearchUser: prepare JSON response: %w", err)
+	if err := c.do(ctx, "create-dbaas-opensearch-user", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASOpensearchUser: %w", err)
 	}
 
 	return bodyresp, nil
@@ -7228,30 +5423,9 @@ func (c Client) DeleteDBAASOpensearchUser(ctx context.Context, serviceName strin
 		return nil, fmt.Errorf("DeleteDBAASOpensearchUser: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASOpensearchUser: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-opensearch-user")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteDBAASOpensearchUser: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASOpensearchUser: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASOpensearchUser: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-dbaas-opensearch-user", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASOpensearchUser: %w", err)
 	}
 
 	return bodyresp, nil
@@ -7283,30 +5457,9 @@ func (c Client) ResetDBAASOpensearchUserPassword(ctx context.Context, serviceNam
 		return nil, fmt.Errorf("ResetDBAASOpensearchUserPassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetDBAASOpensearchUserPassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-dbaas-opensearch-user-password")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ResetDBAASOpensearchUserPassword: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ResetDBAASOpensearchUserPassword: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ResetDBAASOpensearchUserPassword: prepare JSON response: %w", err)
+	if err := c.do(ctx, "reset-dbaas-opensearch-user-password", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ResetDBAASOpensearchUserPassword: %w", err)
 	}
 
 	return bodyresp, nil
@@ -7326,30 +5479,9 @@ func (c Client) RevealDBAASOpensearchUserPassword(ctx context.Context, serviceNa
 		return nil, fmt.Errorf("RevealDBAASOpensearchUserPassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RevealDBAASOpensearchUserPassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reveal-dbaas-opensearch-user-password")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("RevealDBAASOpensearchUserPassword: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("RevealDBAASOpensearchUserPassword: http response: %w", err)
-	}
-
 	bodyresp := new(DBAASUserOpensearchSecrets)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("RevealDBAASOpensearchUserPassword: prepare JSON response: %w", err)
+	if err := c.do(ctx, "reveal-dbaas-opensearch-user-password", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("RevealDBAASOpensearchUserPassword: %w", err)
 	}
 
 	return bodyresp, nil
@@ -7369,30 +5501,9 @@ func (c Client) DeleteDBAASServicePG(ctx context.Context, name string) (*Operati
 		return nil, fmt.Errorf("DeleteDBAASServicePG: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASServicePG: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-service-pg")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteDBAASServicePG: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASServicePG: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASServicePG: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-dbaas-service-pg", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASServicePG: %w", err)
 	}
 
 	return bodyresp, nil
@@ -7413,30 +5524,9 @@ func (c Client) GetDBAASServicePG(ctx context.Context, name string) (*DBAASServi
 		return nil, fmt.Errorf("GetDBAASServicePG: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASServicePG: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-service-pg")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetDBAASServicePG: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetDBAASServicePG: http response: %w", err)
-	}
-
 	bodyresp := new(DBAASServicePG)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetDBAASServicePG: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-dbaas-service-pg", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASServicePG: %w", err)
 	}
 
 	return bodyresp, nil
@@ -7565,33 +5655,37 @@ func (c Client) CreateDBAASServicePG(ctx context.Context, name string, req Creat
 		return nil, fmt.Errorf("CreateDBAASServicePG: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASServicePG: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "create-dbaas-service-pg", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASServicePG: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "create-dbaas-service-pg")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// CreateDBAASServicePGAndWait calls CreateDBAASServicePG, waits for the operation to succeed
+// and returns the created resource.
+func (c Client) CreateDBAASServicePGAndWait(ctx context.Context, name string, req CreateDBAASServicePGRequest) (*DBAASServicePG, error) {
+	op, err := c.CreateDBAASServicePG(ctx, name, req)
 	if err != nil {
-		return nil, fmt.Errorf("CreateDBAASServicePG: http client do: %w", err)
+		return nil, fmt.Errorf("CreateDBAASServicePGAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateDBAASServicePG: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("CreateDBAASServicePGAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateDBAASServicePG: prepare JSON response: %w", err)
+	resp, err := c.GetDBAASServicePG(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("CreateDBAASServicePGAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 type UpdateDBAASServicePGRequestBackupSchedule struct {
@@ -7695,33 +5789,37 @@ func (c Client) UpdateDBAASServicePG(ctx context.Context, name string, req Updat
 		return nil, fmt.Errorf("UpdateDBAASServicePG: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASServicePG: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "update-dbaas-service-pg", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASServicePG: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "update-dbaas-service-pg")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// UpdateDBAASServicePGAndWait calls UpdateDBAASServicePG, waits for the operation to succeed
+// and returns the updated resource.
+func (c Client) UpdateDBAASServicePGAndWait(ctx context.Context, name string, req UpdateDBAASServicePGRequest) (*DBAASServicePG, error) {
+	op, err := c.UpdateDBAASServicePG(ctx, name, req)
 	if err != nil {
-		return nil, fmt.Errorf("UpdateDBAASServicePG: http client do: %w", err)
+		return nil, fmt.Errorf("UpdateDBAASServicePGAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASServicePG: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateDBAASServicePGAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASServicePG: prepare JSON response: %w", err)
+	resp, err := c.GetDBAASServicePG(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateDBAASServicePGAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 func (c Client) StartDBAASPGMaintenance(ctx context.Context, name string) (*Operation, error) {
@@ -7738,30 +5836,9 @@ func (c Client) StartDBAASPGMaintenance(ctx context.Context, name string) (*Oper
 		return nil, fmt.Errorf("StartDBAASPGMaintenance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("StartDBAASPGMaintenance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "start-dbaas-pg-maintenance")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("StartDBAASPGMaintenance: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("StartDBAASPGMaintenance: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("StartDBAASPGMaintenance: prepare JSON response: %w", err)
+	if err := c.do(ctx, "start-dbaas-pg-maintenance", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("StartDBAASPGMaintenance: %w", err)
 	}
 
 	return bodyresp, nil
@@ -7781,30 +5858,9 @@ func (c Client) StopDBAASPGMigration(ctx context.Context, name string) (*Operati
 		return nil, fmt.Errorf("StopDBAASPGMigration: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("StopDBAASPGMigration: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "stop-dbaas-pg-migration")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("StopDBAASPGMigration: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("StopDBAASPGMigration: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("StopDBAASPGMigration: prepare JSON response: %w", err)
+	if err := c.do(ctx, "stop-dbaas-pg-migration", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("StopDBAASPGMigration: %w", err)
 	}
 
 	return bodyresp, nil
@@ -7840,30 +5896,9 @@ func (c Client) CreateDBAASPGConnectionPool(ctx context.Context, serviceName str
 		return nil, fmt.Errorf("CreateDBAASPGConnectionPool: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASPGConnectionPool: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-pg-connection-pool")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("CreateDBAASPGConnectionPool: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateDBAASPGConnectionPool: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateDBAASPGConnectionPool: prepare JSON response: %w", err)
+	if err := c.do(ctx, "create-dbaas-pg-connection-pool", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASPGConnectionPool: %w", err)
 	}
 
 	return bodyresp, nil
@@ -7884,30 +5919,9 @@ func (c Client) DeleteDBAASPGConnectionPool(ctx context.Context, serviceName str
 		return nil, fmt.Errorf("DeleteDBAASPGConnectionPool: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASPGConnectionPool: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-pg-connection-pool")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteDBAASPGConnectionPool: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASPGConnectionPool: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASPGConnectionPool: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-dbaas-pg-connection-pool", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASPGConnectionPool: %w", err)
 	}
 
 	return bodyresp, nil
@@ -7942,30 +5956,9 @@ func (c Client) UpdateDBAASPGConnectionPool(ctx context.Context, serviceName str
 		return nil, fmt.Errorf("UpdateDBAASPGConnectionPool: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASPGConnectionPool: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-dbaas-pg-connection-pool")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("UpdateDBAASPGConnectionPool: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASPGConnectionPool: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASPGConnectionPool: prepare JSON response: %w", err)
+	if err := c.do(ctx, "update-dbaas-pg-connection-pool", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASPGConnectionPool: %w", err)
 	}
 
 	return bodyresp, nil
@@ -8000,30 +5993,9 @@ func (c Client) CreateDBAASPGDatabase(ctx context.Context, serviceName string, r
 		return nil, fmt.Errorf("CreateDBAASPGDatabase: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASPGDatabase: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-pg-database")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("CreateDBAASPGDatabase: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateDBAASPGDatabase: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateDBAASPGDatabase: prepare JSON response: %w", err)
+	if err := c.do(ctx, "create-dbaas-pg-database", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASPGDatabase: %w", err)
 	}
 
 	return bodyresp, nil
@@ -8043,30 +6015,9 @@ func (c Client) DeleteDBAASPGDatabase(ctx context.Context, serviceName string, d
 		return nil, fmt.Errorf("DeleteDBAASPGDatabase: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASPGDatabase: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-pg-database")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteDBAASPGDatabase: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASPGDatabase: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASPGDatabase: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-dbaas-pg-database", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASPGDatabase: %w", err)
 	}
 
 	return bodyresp, nil
@@ -8090,38 +6041,17 @@ func (c Client) CreateDBAASPostgresUser(ctx context.Context, serviceName string,
 		return nil, fmt.Errorf("CreateDBAASPostgresUser: new request: %w", err)
 	}
 
-	request.Header.Add("User-Agent", c.getUserAgent())
-
-	request.Header.Add("Content-Type", "application/json")
-
-	if err := c.executeRequestInterceptors(ctx, request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASPostgresUser: execute request editors: %w", err)
-	}
-
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASPostgresUser: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-postgres-user")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("CreateDBAASPostgresUser: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
+	request.Header.Add("User-Agent", c.getUserAgent())
+
+	request.Header.Add("Content-Type", "application/json")
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateDBAASPostgresUser: http response: %w", err)
+	if err := c.executeRequestInterceptors(ctx, request); err != nil {
+		return nil, fmt.Errorf("CreateDBAASPostgresUser: execute request editors: %w", err)
 	}
 
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateDBAASPostgresUser: prepare JSON response: %w", err)
+	if err := c.do(ctx, "create-dbaas-postgres-user", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASPostgresUser: %w", err)
 	}
 
 	return bodyresp, nil
@@ -8141,30 +6071,9 @@ func (c Client) DeleteDBAASPostgresUser(ctx context.Context, serviceName string,
 		return nil, fmt.Errorf("DeleteDBAASPostgresUser: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASPostgresUser: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-postgres-user")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteDBAASPostgresUser: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASPostgresUser: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASPostgresUser: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-dbaas-postgres-user", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASPostgresUser: %w", err)
 	}
 
 	return bodyresp, nil
@@ -8195,30 +6104,9 @@ func (c Client) UpdateDBAASPostgresAllowReplication(ctx context.Context, service
 		return nil, fmt.Errorf("UpdateDBAASPostgresAllowReplication: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASPostgresAllowReplication: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-dbaas-postgres-allow-replication")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("UpdateDBAASPostgresAllowReplication: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASPostgresAllowReplication: http response: %w", err)
-	}
-
 	bodyresp := new(DBAASPostgresUsers)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASPostgresAllowReplication: prepare JSON response: %w", err)
+	if err := c.do(ctx, "update-dbaas-postgres-allow-replication", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASPostgresAllowReplication: %w", err)
 	}
 
 	return bodyresp, nil
@@ -8250,30 +6138,9 @@ func (c Client) ResetDBAASPostgresUserPassword(ctx context.Context, serviceName
 		return nil, fmt.Errorf("ResetDBAASPostgresUserPassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetDBAASPostgresUserPassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-dbaas-postgres-user-password")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ResetDBAASPostgresUserPassword: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ResetDBAASPostgresUserPassword: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ResetDBAASPostgresUserPassword: prepare JSON response: %w", err)
+	if err := c.do(ctx, "reset-dbaas-postgres-user-password", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ResetDBAASPostgresUserPassword: %w", err)
 	}
 
 	return bodyresp, nil
@@ -8293,30 +6160,9 @@ func (c Client) RevealDBAASPostgresUserPassword(ctx context.Context, serviceName
 		return nil, fmt.Errorf("RevealDBAASPostgresUserPassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RevealDBAASPostgresUserPassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reveal-dbaas-postgres-user-password")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("RevealDBAASPostgresUserPassword: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("RevealDBAASPostgresUserPassword: http response: %w", err)
-	}
-
 	bodyresp := new(DBAASUserPostgresSecrets)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("RevealDBAASPostgresUserPassword: prepare JSON response: %w", err)
+	if err := c.do(ctx, "reveal-dbaas-postgres-user-password", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("RevealDBAASPostgresUserPassword: %w", err)
 	}
 
 	return bodyresp, nil
@@ -8348,30 +6194,9 @@ func (c Client) CreateDBAASPGUpgradeCheck(ctx context.Context, service string, r
 		return nil, fmt.Errorf("CreateDBAASPGUpgradeCheck: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASPGUpgradeCheck: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-pg-upgrade-check")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("CreateDBAASPGUpgradeCheck: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateDBAASPGUpgradeCheck: http response: %w", err)
-	}
-
 	bodyresp := new(DBAASTask)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateDBAASPGUpgradeCheck: prepare JSON response: %w", err)
+	if err := c.do(ctx, "create-dbaas-pg-upgrade-check", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASPGUpgradeCheck: %w", err)
 	}
 
 	return bodyresp, nil
@@ -8415,33 +6240,41 @@ func (c Client) ListDBAASServices(ctx context.Context) (*ListDBAASServicesRespon
 		return nil, fmt.Errorf("ListDBAASServices: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListDBAASServices: sign request: %w", err)
+	bodyresp := new(ListDBAASServicesResponse)
+	if err := c.do(ctx, "list-dbaas-services", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ListDBAASServices: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "list-dbaas-services")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ListDBAASServices: http client do: %w", err)
-	}
+// ListDBAASServicesSeq returns an iterator over the elements listed by ListDBAASServices,
+// decoded while the response is read. The iteration stops at the first error,
+// yielded with a zero element, or once the context is done.
+func (c Client) ListDBAASServicesSeq(ctx context.Context) iter.Seq2[DBAASServiceCommon, error] {
+	return func(yield func(DBAASServiceCommon, error) bool) {
+		path := "/dbaas-service"
 
-	if c.trace {
-		dumpResponse(response)
-	}
+		request, err := http.NewRequestWithContext(ctx, "GET", c.serverEndpoint+path, nil)
+		if err != nil {
+			yieldError(yield, fmt.Errorf("ListDBAASServicesSeq: new request: %w", err))
+			return
+		}
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ListDBAASServices: http response: %w", err)
-	}
+		request.Header.Add("User-Agent", c.getUserAgent())
 
-	bodyresp := new(ListDBAASServicesResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ListDBAASServices: prepare JSON response: %w", err)
-	}
+		if err := c.executeRequestInterceptors(ctx, request); err != nil {
+			yieldError(yield, fmt.Errorf("ListDBAASServicesSeq: execute request editors: %w", err))
+			return
+		}
 
-	return bodyresp, nil
+		bodyresp := new(ListDBAASServicesResponse)
+		call := &Call{OperationID: "list-dbaas-services", Request: request, Result: bodyresp}
+		elems := func() []DBAASServiceCommon { return bodyresp.DBAASServices }
+		if err := streamList(ctx, c, call, true, "dbaas-services", elems, yield); err != nil {
+			yieldError(yield, fmt.Errorf("ListDBAASServicesSeq: %w", err))
+		}
+	}
 }
 
 type GetDBAASServiceLogsRequest struct {
@@ -8474,30 +6307,9 @@ func (c Client) GetDBAASServiceLogs(ctx context.Context, serviceName string, req
 		return nil, fmt.Errorf("GetDBAASServiceLogs: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceLogs: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-service-logs")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceLogs: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceLogs: http response: %w", err)
-	}
-
 	bodyresp := new(DBAASServiceLogs)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceLogs: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-dbaas-service-logs", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASServiceLogs: %w", err)
 	}
 
 	return bodyresp, nil
@@ -8544,30 +6356,9 @@ func (c Client) GetDBAASServiceMetrics(ctx context.Context, serviceName string,
 		return nil, fmt.Errorf("GetDBAASServiceMetrics: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceMetrics: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-service-metrics")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceMetrics: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceMetrics: http response: %w", err)
-	}
-
 	bodyresp := new(GetDBAASServiceMetricsResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceMetrics: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-dbaas-service-metrics", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASServiceMetrics: %w", err)
 	}
 
 	return bodyresp, nil
@@ -8611,33 +6402,41 @@ func (c Client) ListDBAASServiceTypes(ctx context.Context) (*ListDBAASServiceTyp
 		return nil, fmt.Errorf("ListDBAASServiceTypes: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListDBAASServiceTypes: sign request: %w", err)
+	bodyresp := new(ListDBAASServiceTypesResponse)
+	if err := c.do(ctx, "list-dbaas-service-types", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ListDBAASServiceTypes: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "list-dbaas-service-types")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ListDBAASServiceTypes: http client do: %w", err)
-	}
+// ListDBAASServiceTypesSeq returns an iterator over the elements listed by ListDBAASServiceTypes,
+// decoded while the response is read. The iteration stops at the first error,
+// yielded with a zero element, or once the context is done.
+func (c Client) ListDBAASServiceTypesSeq(ctx context.Context) iter.Seq2[DBAASServiceType, error] {
+	return func(yield func(DBAASServiceType, error) bool) {
+		path := "/dbaas-service-type"
 
-	if c.trace {
-		dumpResponse(response)
-	}
+		request, err := http.NewRequestWithContext(ctx, "GET", c.serverEndpoint+path, nil)
+		if err != nil {
+			yieldError(yield, fmt.Errorf("ListDBAASServiceTypesSeq: new request: %w", err))
+			return
+		}
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ListDBAASServiceTypes: http response: %w", err)
-	}
+		request.Header.Add("User-Agent", c.getUserAgent())
 
-	bodyresp := new(ListDBAASServiceTypesResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ListDBAASServiceTypes: prepare JSON response: %w", err)
-	}
+		if err := c.executeRequestInterceptors(ctx, request); err != nil {
+			yieldError(yield, fmt.Errorf("ListDBAASServiceTypesSeq: execute request editors: %w", err))
+			return
+		}
 
-	return bodyresp, nil
+		bodyresp := new(ListDBAASServiceTypesResponse)
+		call := &Call{OperationID: "list-dbaas-service-types", Request: request, Result: bodyresp}
+		elems := func() []DBAASServiceType { return bodyresp.DBAASServiceTypes }
+		if err := streamList(ctx, c, call, true, "dbaas-service-types", elems, yield); err != nil {
+			yieldError(yield, fmt.Errorf("ListDBAASServiceTypesSeq: %w", err))
+		}
+	}
 }
 
 // Get a DBaaS service type
@@ -8655,30 +6454,9 @@ func (c Client) GetDBAASServiceType(ctx context.Context, serviceTypeName string)
 		return nil, fmt.Errorf("GetDBAASServiceType: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceType: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-service-type")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceType: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceType: http response: %w", err)
-	}
-
 	bodyresp := new(DBAASServiceType)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceType: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-dbaas-service-type", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASServiceType: %w", err)
 	}
 
 	return bodyresp, nil
@@ -8699,30 +6477,9 @@ func (c Client) DeleteDBAASService(ctx context.Context, name string) (*Operation
 		return nil, fmt.Errorf("DeleteDBAASService: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASService: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-service")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteDBAASService: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASService: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASService: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-dbaas-service", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASService: %w", err)
 	}
 
 	return bodyresp, nil
@@ -8759,30 +6516,9 @@ func (c Client) GetDBAASSettingsClickhouse(ctx context.Context) (*GetDBAASSettin
 		return nil, fmt.Errorf("GetDBAASSettingsClickhouse: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASSettingsClickhouse: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-settings-clickhouse")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetDBAASSettingsClickhouse: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetDBAASSettingsClickhouse: http response: %w", err)
-	}
-
 	bodyresp := new(GetDBAASSettingsClickhouseResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetDBAASSettingsClickhouse: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-dbaas-settings-clickhouse", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASSettingsClickhouse: %w", err)
 	}
 
 	return bodyresp, nil
@@ -8820,30 +6556,9 @@ func (c Client) GetDBAASSettingsGrafana(ctx context.Context) (*GetDBAASSettingsG
 		return nil, fmt.Errorf("GetDBAASSettingsGrafana: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASSettingsGrafana: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-settings-grafana")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetDBAASSettingsGrafana: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetDBAASSettingsGrafana: http response: %w", err)
-	}
-
 	bodyresp := new(GetDBAASSettingsGrafanaResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetDBAASSettingsGrafana: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-dbaas-settings-grafana", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASSettingsGrafana: %w", err)
 	}
 
 	return bodyresp, nil
@@ -8911,30 +6626,9 @@ func (c Client) GetDBAASSettingsKafka(ctx context.Context) (*GetDBAASSettingsKaf
 		return nil, fmt.Errorf("GetDBAASSettingsKafka: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASSettingsKafka: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-settings-kafka")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetDBAASSettingsKafka: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetDBAASSettingsKafka: http response: %w", err)
-	}
-
 	bodyresp := new(GetDBAASSettingsKafkaResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetDBAASSettingsKafka: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-dbaas-settings-kafka", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASSettingsKafka: %w", err)
 	}
 
 	return bodyresp, nil
@@ -8972,30 +6666,9 @@ func (c Client) GetDBAASSettingsMysql(ctx context.Context) (*GetDBAASSettingsMys
 		return nil, fmt.Errorf("GetDBAASSettingsMysql: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASSettingsMysql: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-settings-mysql")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetDBAASSettingsMysql: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetDBAASSettingsMysql: http response: %w", err)
-	}
-
 	bodyresp := new(GetDBAASSettingsMysqlResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetDBAASSettingsMysql: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-dbaas-settings-mysql", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASSettingsMysql: %w", err)
 	}
 
 	return bodyresp, nil
@@ -9033,30 +6706,9 @@ func (c Client) GetDBAASSettingsOpensearch(ctx context.Context) (*GetDBAASSettin
 		return nil, fmt.Errorf("GetDBAASSettingsOpensearch: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASSettingsOpensearch: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-settings-opensearch")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetDBAASSettingsOpensearch: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetDBAASSettingsOpensearch: http response: %w", err)
-	}
-
 	bodyresp := new(GetDBAASSettingsOpensearchResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetDBAASSettingsOpensearch: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-dbaas-settings-opensearch", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASSettingsOpensearch: %w", err)
 	}
 
 	return bodyresp, nil
@@ -9124,30 +6776,9 @@ func (c Client) GetDBAASSettingsPG(ctx context.Context) (*GetDBAASSettingsPGResp
 		return nil, fmt.Errorf("GetDBAASSettingsPG: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASSettingsPG: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-settings-pg")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetDBAASSettingsPG: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetDBAASSettingsPG: http response: %w", err)
-	}
-
 	bodyresp := new(GetDBAASSettingsPGResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetDBAASSettingsPG: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-dbaas-settings-pg", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASSettingsPG: %w", err)
 	}
 
 	return bodyresp, nil
@@ -9185,30 +6816,9 @@ func (c Client) GetDBAASSettingsThanos(ctx context.Context) (*GetDBAASSettingsTh
 		return nil, fmt.Errorf("GetDBAASSettingsThanos: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASSettingsThanos: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-settings-thanos")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetDBAASSettingsThanos: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetDBAASSettingsThanos: http response: %w", err)
-	}
-
 	bodyresp := new(GetDBAASSettingsThanosResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetDBAASSettingsThanos: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-dbaas-settings-thanos", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASSettingsThanos: %w", err)
 	}
 
 	return bodyresp, nil
@@ -9246,30 +6856,9 @@ func (c Client) GetDBAASSettingsValkey(ctx context.Context) (*GetDBAASSettingsVa
 		return nil, fmt.Errorf("GetDBAASSettingsValkey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASSettingsValkey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-settings-valkey")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetDBAASSettingsValkey: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetDBAASSettingsValkey: http response: %w", err)
-	}
-
 	bodyresp := new(GetDBAASSettingsValkeyResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetDBAASSettingsValkey: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-dbaas-settings-valkey", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASSettingsValkey: %w", err)
 	}
 
 	return bodyresp, nil
@@ -9305,30 +6894,9 @@ func (c Client) CreateDBAASTaskMigrationCheck(ctx context.Context, service strin
 		return nil, fmt.Errorf("CreateDBAASTaskMigrationCheck: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASTaskMigrationCheck: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-task-migration-check")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("CreateDBAASTaskMigrationCheck: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateDBAASTaskMigrationCheck: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateDBAASTaskMigrationCheck: prepare JSON response: %w", err)
+	if err := c.do(ctx, "create-dbaas-task-migration-check", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASTaskMigrationCheck: %w", err)
 	}
 
 	return bodyresp, nil
@@ -9349,30 +6917,9 @@ func (c Client) GetDBAASTask(ctx context.Context, service string, id UUID) (*DBA
 		return nil, fmt.Errorf("GetDBAASTask: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASTask: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-task")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetDBAASTask: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetDBAASTask: http response: %w", err)
-	}
-
 	bodyresp := new(DBAASTask)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetDBAASTask: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-dbaas-task", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASTask: %w", err)
 	}
 
 	return bodyresp, nil
@@ -9392,30 +6939,9 @@ func (c Client) DeleteDBAASServiceThanos(ctx context.Context, name string) (*Ope
 		return nil, fmt.Errorf("DeleteDBAASServiceThanos: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASServiceThanos: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-service-thanos")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteDBAASServiceThanos: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASServiceThanos: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASServiceThanos: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-dbaas-service-thanos", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASServiceThanos: %w", err)
 	}
 
 	return bodyresp, nil
@@ -9436,30 +6962,9 @@ func (c Client) GetDBAASServiceThanos(ctx context.Context, name string) (*DBAASS
 		return nil, fmt.Errorf("GetDBAASServiceThanos: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceThanos: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-service-thanos")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceThanos: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceThanos: http response: %w", err)
-	}
-
 	bodyresp := new(DBAASServiceThanos)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceThanos: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-dbaas-service-thanos", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASServiceThanos: %w", err)
 	}
 
 	return bodyresp, nil
@@ -9521,33 +7026,37 @@ func (c Client) CreateDBAASServiceThanos(ctx context.Context, name string, req C
 		return nil, fmt.Errorf("CreateDBAASServiceThanos: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASServiceThanos: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "create-dbaas-service-thanos", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASServiceThanos: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "create-dbaas-service-thanos")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// CreateDBAASServiceThanosAndWait calls CreateDBAASServiceThanos, waits for the operation to succeed
+// and returns the created resource.
+func (c Client) CreateDBAASServiceThanosAndWait(ctx context.Context, name string, req CreateDBAASServiceThanosRequest) (*DBAASServiceThanos, error) {
+	op, err := c.CreateDBAASServiceThanos(ctx, name, req)
 	if err != nil {
-		return nil, fmt.Errorf("CreateDBAASServiceThanos: http client do: %w", err)
+		return nil, fmt.Errorf("CreateDBAASServiceThanosAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateDBAASServiceThanos: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("CreateDBAASServiceThanosAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateDBAASServiceThanos: prepare JSON response: %w", err)
+	resp, err := c.GetDBAASServiceThanos(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("CreateDBAASServiceThanosAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 type UpdateDBAASServiceThanosRequestMaintenanceDow string
@@ -9606,33 +7115,37 @@ func (c Client) UpdateDBAASServiceThanos(ctx context.Context, name string, req U
 		return nil, fmt.Errorf("UpdateDBAASServiceThanos: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASServiceThanos: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "update-dbaas-service-thanos", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASServiceThanos: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "update-dbaas-service-thanos")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// UpdateDBAASServiceThanosAndWait calls UpdateDBAASServiceThanos, waits for the operation to succeed
+// and returns the updated resource.
+func (c Client) UpdateDBAASServiceThanosAndWait(ctx context.Context, name string, req UpdateDBAASServiceThanosRequest) (*DBAASServiceThanos, error) {
+	op, err := c.UpdateDBAASServiceThanos(ctx, name, req)
 	if err != nil {
-		return nil, fmt.Errorf("UpdateDBAASServiceThanos: http client do: %w", err)
+		return nil, fmt.Errorf("UpdateDBAASServiceThanosAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASServiceThanos: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateDBAASServiceThanosAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASServiceThanos: prepare JSON response: %w", err)
+	resp, err := c.GetDBAASServiceThanos(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateDBAASServiceThanosAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 func (c Client) StartDBAASThanosMaintenance(ctx context.Context, name string) (*Operation, error) {
@@ -9649,30 +7162,9 @@ func (c Client) StartDBAASThanosMaintenance(ctx context.Context, name string) (*
 		return nil, fmt.Errorf("StartDBAASThanosMaintenance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("StartDBAASThanosMaintenance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "start-dbaas-thanos-maintenance")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("StartDBAASThanosMaintenance: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("StartDBAASThanosMaintenance: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("StartDBAASThanosMaintenance: prepare JSON response: %w", err)
+	if err := c.do(ctx, "start-dbaas-thanos-maintenance", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("StartDBAASThanosMaintenance: %w", err)
 	}
 
 	return bodyresp, nil
@@ -9692,30 +7184,9 @@ func (c Client) RevealDBAASThanosUserPassword(ctx context.Context, serviceName s
 		return nil, fmt.Errorf("RevealDBAASThanosUserPassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RevealDBAASThanosUserPassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reveal-dbaas-thanos-user-password")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("RevealDBAASThanosUserPassword: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("RevealDBAASThanosUserPassword: http response: %w", err)
-	}
-
 	bodyresp := new(DBAASUserThanosSecrets)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("RevealDBAASThanosUserPassword: prepare JSON response: %w", err)
+	if err := c.do(ctx, "reveal-dbaas-thanos-user-password", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("RevealDBAASThanosUserPassword: %w", err)
 	}
 
 	return bodyresp, nil
@@ -9735,30 +7206,9 @@ func (c Client) DeleteDBAASServiceValkey(ctx context.Context, name string) (*Ope
 		return nil, fmt.Errorf("DeleteDBAASServiceValkey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASServiceValkey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-service-valkey")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteDBAASServiceValkey: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASServiceValkey: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASServiceValkey: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-dbaas-service-valkey", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASServiceValkey: %w", err)
 	}
 
 	return bodyresp, nil
@@ -9779,30 +7229,9 @@ func (c Client) GetDBAASServiceValkey(ctx context.Context, name string) (*DBAASS
 		return nil, fmt.Errorf("GetDBAASServiceValkey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceValkey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-service-valkey")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceValkey: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceValkey: http response: %w", err)
-	}
-
 	bodyresp := new(DBAASServiceValkey)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceValkey: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-dbaas-service-valkey", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASServiceValkey: %w", err)
 	}
 
 	return bodyresp, nil
@@ -9890,33 +7319,37 @@ func (c Client) CreateDBAASServiceValkey(ctx context.Context, name string, req C
 		return nil, fmt.Errorf("CreateDBAASServiceValkey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASServiceValkey: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "create-dbaas-service-valkey", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASServiceValkey: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "create-dbaas-service-valkey")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// CreateDBAASServiceValkeyAndWait calls CreateDBAASServiceValkey, waits for the operation to succeed
+// and returns the created resource.
+func (c Client) CreateDBAASServiceValkeyAndWait(ctx context.Context, name string, req CreateDBAASServiceValkeyRequest) (*DBAASServiceValkey, error) {
+	op, err := c.CreateDBAASServiceValkey(ctx, name, req)
 	if err != nil {
-		return nil, fmt.Errorf("CreateDBAASServiceValkey: http client do: %w", err)
+		return nil, fmt.Errorf("CreateDBAASServiceValkeyAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateDBAASServiceValkey: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("CreateDBAASServiceValkeyAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateDBAASServiceValkey: prepare JSON response: %w", err)
+	resp, err := c.GetDBAASServiceValkey(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("CreateDBAASServiceValkeyAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 type UpdateDBAASServiceValkeyRequestMaintenanceDow string
@@ -9998,33 +7431,37 @@ func (c Client) UpdateDBAASServiceValkey(ctx context.Context, name string, req U
 		return nil, fmt.Errorf("UpdateDBAASServiceValkey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASServiceValkey: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "update-dbaas-service-valkey", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASServiceValkey: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "update-dbaas-service-valkey")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// UpdateDBAASServiceValkeyAndWait calls UpdateDBAASServiceValkey, waits for the operation to succeed
+// and returns the updated resource.
+func (c Client) UpdateDBAASServiceValkeyAndWait(ctx context.Context, name string, req UpdateDBAASServiceValkeyRequest) (*DBAASServiceValkey, error) {
+	op, err := c.UpdateDBAASServiceValkey(ctx, name, req)
 	if err != nil {
-		return nil, fmt.Errorf("UpdateDBAASServiceValkey: http client do: %w", err)
+		return nil, fmt.Errorf("UpdateDBAASServiceValkeyAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASServiceValkey: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateDBAASServiceValkeyAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASServiceValkey: prepare JSON response: %w", err)
+	resp, err := c.GetDBAASServiceValkey(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateDBAASServiceValkeyAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 func (c Client) StartDBAASValkeyMaintenance(ctx context.Context, name string) (*Operation, error) {
@@ -10041,30 +7478,9 @@ func (c Client) StartDBAASValkeyMaintenance(ctx context.Context, name string) (*
 		return nil, fmt.Errorf("StartDBAASValkeyMaintenance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("StartDBAASValkeyMaintenance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "start-dbaas-valkey-maintenance")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("StartDBAASValkeyMaintenance: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("StartDBAASValkeyMaintenance: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("StartDBAASValkeyMaintenance: prepare JSON response: %w", err)
+	if err := c.do(ctx, "start-dbaas-valkey-maintenance", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("StartDBAASValkeyMaintenance: %w", err)
 	}
 
 	return bodyresp, nil
@@ -10084,30 +7500,9 @@ func (c Client) StopDBAASValkeyMigration(ctx context.Context, name string) (*Ope
 		return nil, fmt.Errorf("StopDBAASValkeyMigration: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("StopDBAASValkeyMigration: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "stop-dbaas-valkey-migration")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("StopDBAASValkeyMigration: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("StopDBAASValkeyMigration: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("StopDBAASValkeyMigration: prepare JSON response: %w", err)
+	if err := c.do(ctx, "stop-dbaas-valkey-migration", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("StopDBAASValkeyMigration: %w", err)
 	}
 
 	return bodyresp, nil
@@ -10127,33 +7522,41 @@ func (c Client) ListDBAASValkeyUsers(ctx context.Context, serviceName string) (*
 		return nil, fmt.Errorf("ListDBAASValkeyUsers: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListDBAASValkeyUsers: sign request: %w", err)
+	bodyresp := new(DBAASValkeyUsers)
+	if err := c.do(ctx, "list-dbaas-valkey-users", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ListDBAASValkeyUsers: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "list-dbaas-valkey-users")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ListDBAASValkeyUsers: http client do: %w", err)
-	}
+// ListDBAASValkeyUsersSeq returns an iterator over the elements listed by ListDBAASValkeyUsers,
+// decoded while the response is read. The iteration stops at the first error,
+// yielded with a zero element, or once the context is done.
+func (c Client) ListDBAASValkeyUsersSeq(ctx context.Context, serviceName string) iter.Seq2[DBAASValkeyUser, error] {
+	return func(yield func(DBAASValkeyUser, error) bool) {
+		path := fmt.Sprintf("/dbaas-valkey/%v/user", serviceName)
 
-	if c.trace {
-		dumpResponse(response)
-	}
+		request, err := http.NewRequestWithContext(ctx, "GET", c.serverEndpoint+path, nil)
+		if err != nil {
+			yieldError(yield, fmt.Errorf("ListDBAASValkeyUsersSeq: new request: %w", err))
+			return
+		}
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ListDBAASValkeyUsers: http response: %w", err)
-	}
+		request.Header.Add("User-Agent", c.getUserAgent())
 
-	bodyresp := new(DBAASValkeyUsers)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ListDBAASValkeyUsers: prepare JSON response: %w", err)
-	}
+		if err := c.executeRequestInterceptors(ctx, request); err != nil {
+			yieldError(yield, fmt.Errorf("ListDBAASValkeyUsersSeq: execute request editors: %w", err))
+			return
+		}
 
-	return bodyresp, nil
+		bodyresp := new(DBAASValkeyUsers)
+		call := &Call{OperationID: "list-dbaas-valkey-users", Request: request, Result: bodyresp}
+		elems := func() []DBAASValkeyUser { return bodyresp.Users }
+		if err := streamList(ctx, c, call, true, "users", elems, yield); err != nil {
+			yieldError(yield, fmt.Errorf("ListDBAASValkeyUsersSeq: %w", err))
+		}
+	}
 }
 
 type CreateDBAASValkeyUserRequest struct {
@@ -10182,30 +7585,9 @@ func (c Client) CreateDBAASValkeyUser(ctx context.Context, serviceName string, r
 		return nil, fmt.Errorf("CreateDBAASValkeyUser: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASValkeyUser: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-valkey-user")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("CreateDBAASValkeyUser: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateDBAASValkeyUser: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateDBAASValkeyUser: prepare JSON response: %w", err)
+	if err := c.do(ctx, "create-dbaas-valkey-user", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASValkeyUser: %w", err)
 	}
 
 	return bodyresp, nil
@@ -10225,30 +7607,9 @@ func (c Client) DeleteDBAASValkeyUser(ctx context.Context, serviceName string, u
 		return nil, fmt.Errorf("DeleteDBAASValkeyUser: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASValkeyUser: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-valkey-user")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteDBAASValkeyUser: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASValkeyUser: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASValkeyUser: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-dbaas-valkey-user", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASValkeyUser: %w", err)
 	}
 
 	return bodyresp, nil
@@ -10279,30 +7640,9 @@ func (c Client) UpdateDBAASValkeyUserAccessControl(ctx context.Context, serviceN
 		return nil, fmt.Errorf("UpdateDBAASValkeyUserAccessControl: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASValkeyUserAccessControl: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-dbaas-valkey-user-access-control")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("UpdateDBAASValkeyUserAccessControl: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASValkeyUserAccessControl: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASValkeyUserAccessControl: prepare JSON response: %w", err)
+	if err := c.do(ctx, "update-dbaas-valkey-user-access-control", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASValkeyUserAccessControl: %w", err)
 	}
 
 	return bodyresp, nil
@@ -10334,30 +7674,9 @@ func (c Client) ResetDBAASValkeyUserPassword(ctx context.Context, serviceName st
 		return nil, fmt.Errorf("ResetDBAASValkeyUserPassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetDBAASValkeyUserPassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-dbaas-valkey-user-password")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ResetDBAASValkeyUserPassword: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ResetDBAASValkeyUserPassword: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ResetDBAASValkeyUserPassword: prepare JSON response: %w", err)
+	if err := c.do(ctx, "reset-dbaas-valkey-user-password", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ResetDBAASValkeyUserPassword: %w", err)
 	}
 
 	return bodyresp, nil
@@ -10377,30 +7696,9 @@ func (c Client) RevealDBAASValkeyUserPassword(ctx context.Context, serviceName s
 		return nil, fmt.Errorf("RevealDBAASValkeyUserPassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RevealDBAASValkeyUserPassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reveal-dbaas-valkey-user-password")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("RevealDBAASValkeyUserPassword: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("RevealDBAASValkeyUserPassword: http response: %w", err)
-	}
-
 	bodyresp := new(DBAASUserValkeySecrets)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("RevealDBAASValkeyUserPassword: prepare JSON response: %w", err)
+	if err := c.do(ctx, "reveal-dbaas-valkey-user-password", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("RevealDBAASValkeyUserPassword: %w", err)
 	}
 
 	return bodyresp, nil
@@ -10444,33 +7742,41 @@ func (c Client) ListDeployTargets(ctx context.Context) (*ListDeployTargetsRespon
 		return nil, fmt.Errorf("ListDeployTargets: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListDeployTargets: sign request: %w", err)
+	bodyresp := new(ListDeployTargetsResponse)
+	if err := c.do(ctx, "list-deploy-targets", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ListDeployTargets: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "list-deploy-targets")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ListDeployTargets: http client do: %w", err)
-	}
+// ListDeployTargetsSeq returns an iterator over the elements listed by ListDeployTargets,
+// decoded while the response is read. The iteration stops at the first error,
+// yielded with a zero element, or once the context is done.
+func (c Client) ListDeployTargetsSeq(ctx context.Context) iter.Seq2[DeployTarget, error] {
+	return func(yield func(DeployTarget, error) bool) {
+		path := "/deploy-target"
 
-	if c.trace {
-		dumpResponse(response)
-	}
+		request, err := http.NewRequestWithContext(ctx, "GET", c.serverEndpoint+path, nil)
+		if err != nil {
+			yieldError(yield, fmt.Errorf("ListDeployTargetsSeq: new request: %w", err))
+			return
+		}
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ListDeployTargets: http response: %w", err)
-	}
+		request.Header.Add("User-Agent", c.getUserAgent())
 
-	bodyresp := new(ListDeployTargetsResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ListDeployTargets: prepare JSON response: %w", err)
-	}
+		if err := c.executeRequestInterceptors(ctx, request); err != nil {
+			yieldError(yield, fmt.Errorf("ListDeployTargetsSeq: execute request editors: %w", err))
+			return
+		}
 
-	return bodyresp, nil
+		bodyresp := new(ListDeployTargetsResponse)
+		call := &Call{OperationID: "list-deploy-targets", Request: request, Result: bodyresp}
+		elems := func() []DeployTarget { return bodyresp.DeployTargets }
+		if err := streamList(ctx, c, call, true, "deploy-targets", elems, yield); err != nil {
+			yieldError(yield, fmt.Errorf("ListDeployTargetsSeq: %w", err))
+		}
+	}
 }
 
 // Retrieve Deploy Target details
@@ -10488,30 +7794,9 @@ func (c Client) GetDeployTarget(ctx context.Context, id UUID) (*DeployTarget, er
 		return nil, fmt.Errorf("GetDeployTarget: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDeployTarget: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-deploy-target")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetDeployTarget: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetDeployTarget: http response: %w", err)
-	}
-
 	bodyresp := new(DeployTarget)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetDeployTarget: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-deploy-target", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDeployTarget: %w", err)
 	}
 
 	return bodyresp, nil
@@ -10555,33 +7840,41 @@ func (c Client) ListDNSDomains(ctx context.Context) (*ListDNSDomainsResponse, er
 		return nil, fmt.Errorf("ListDNSDomains: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListDNSDomains: sign request: %w", err)
+	bodyresp := new(ListDNSDomainsResponse)
+	if err := c.do(ctx, "list-dns-domains", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ListDNSDomains: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "list-dns-domains")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ListDNSDomains: http client do: %w", err)
-	}
+// ListDNSDomainsSeq returns an iterator over the elements listed by ListDNSDomains,
+// decoded while the response is read. The iteration stops at the first error,
+// yielded with a zero element, or once the context is done.
+func (c Client) ListDNSDomainsSeq(ctx context.Context) iter.Seq2[DNSDomain, error] {
+	return func(yield func(DNSDomain, error) bool) {
+		path := "/dns-domain"
 
-	if c.trace {
-		dumpResponse(response)
-	}
+		request, err := http.NewRequestWithContext(ctx, "GET", c.serverEndpoint+path, nil)
+		if err != nil {
+			yieldError(yield, fmt.Errorf("ListDNSDomainsSeq: new request: %w", err))
+			return
+		}
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ListDNSDomains: http response: %w", err)
-	}
+		request.Header.Add("User-Agent", c.getUserAgent())
 
-	bodyresp := new(ListDNSDomainsResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ListDNSDomains: prepare JSON response: %w", err)
-	}
+		if err := c.executeRequestInterceptors(ctx, request); err != nil {
+			yieldError(yield, fmt.Errorf("ListDNSDomainsSeq: execute request editors: %w", err))
+			return
+		}
 
-	return bodyresp, nil
+		bodyresp := new(ListDNSDomainsResponse)
+		call := &Call{OperationID: "list-dns-domains", Request: request, Result: bodyresp}
+		elems := func() []DNSDomain { return bodyresp.DNSDomains }
+		if err := streamList(ctx, c, call, true, "dns-domains", elems, yield); err != nil {
+			yieldError(yield, fmt.Errorf("ListDNSDomainsSeq: %w", err))
+		}
+	}
 }
 
 // DNS Domain
@@ -10612,33 +7905,40 @@ func (c Client) CreateDNSDomain(ctx context.Context, req CreateDNSDomainRequest)
 		return nil, fmt.Errorf("CreateDNSDomain: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDNSDomain: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "create-dns-domain", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDNSDomain: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "create-dns-domain")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// CreateDNSDomainAndWait calls CreateDNSDomain, waits for the operation to succeed
+// and returns the created resource.
+func (c Client) CreateDNSDomainAndWait(ctx context.Context, req CreateDNSDomainRequest) (*DNSDomain, error) {
+	op, err := c.CreateDNSDomain(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("CreateDNSDomain: http client do: %w", err)
+		return nil, fmt.Errorf("CreateDNSDomainAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateDNSDomain: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("CreateDNSDomainAndWait: %w", &OperationError{ID: op.ID, Err: err})
+	}
+	if done.Reference == nil {
+		return nil, fmt.Errorf("CreateDNSDomainAndWait: %w", &OperationError{ID: op.ID, Err: ErrMissingOperationReference})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateDNSDomain: prepare JSON response: %w", err)
+	resp, err := c.GetDNSDomain(ctx, done.Reference.ID)
+	if err != nil {
+		return nil, fmt.Errorf("CreateDNSDomainAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 type ListDNSDomainRecordsResponse struct {
@@ -10679,33 +7979,41 @@ func (c Client) ListDNSDomainRecords(ctx context.Context, domainID UUID) (*ListD
 		return nil, fmt.Errorf("ListDNSDomainRecords: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListDNSDomainRecords: sign request: %w", err)
+	bodyresp := new(ListDNSDomainRecordsResponse)
+	if err := c.do(ctx, "list-dns-domain-records", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ListDNSDomainRecords: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "list-dns-domain-records")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ListDNSDomainRecords: http client do: %w", err)
-	}
+// ListDNSDomainRecordsSeq returns an iterator over the elements listed by ListDNSDomainRecords,
+// decoded while the response is read. The iteration stops at the first error,
+// yielded with a zero element, or once the context is done.
+func (c Client) ListDNSDomainRecordsSeq(ctx context.Context, domainID UUID) iter.Seq2[DNSDomainRecord, error] {
+	return func(yield func(DNSDomainRecord, error) bool) {
+		path := fmt.Sprintf("/dns-domain/%v/record", domainID)
 
-	if c.trace {
-		dumpResponse(response)
-	}
+		request, err := http.NewRequestWithContext(ctx, "GET", c.serverEndpoint+path, nil)
+		if err != nil {
+			yieldError(yield, fmt.Errorf("ListDNSDomainRecordsSeq: new request: %w", err))
+			return
+		}
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ListDNSDomainRecords: http response: %w", err)
-	}
+		request.Header.Add("User-Agent", c.getUserAgent())
 
-	bodyresp := new(ListDNSDomainRecordsResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ListDNSDomainRecords: prepare JSON response: %w", err)
-	}
+		if err := c.executeRequestInterceptors(ctx, request); err != nil {
+			yieldError(yield, fmt.Errorf("ListDNSDomainRecordsSeq: execute request editors: %w", err))
+			return
+		}
 
-	return bodyresp, nil
+		bodyresp := new(ListDNSDomainRecordsResponse)
+		call := &Call{OperationID: "list-dns-domain-records", Request: request, Result: bodyresp}
+		elems := func() []DNSDomainRecord { return bodyresp.DNSDomainRecords }
+		if err := streamList(ctx, c, call, true, "dns-domain-records", elems, yield); err != nil {
+			yieldError(yield, fmt.Errorf("ListDNSDomainRecordsSeq: %w", err))
+		}
+	}
 }
 
 type CreateDNSDomainRecordRequestType string
@@ -10762,33 +8070,40 @@ func (c Client) CreateDNSDomainRecord(ctx context.Context, domainID UUID, req Cr
 		return nil, fmt.Errorf("CreateDNSDomainRecord: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDNSDomainRecord: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "create-dns-domain-record", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDNSDomainRecord: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "create-dns-domain-record")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// CreateDNSDomainRecordAndWait calls CreateDNSDomainRecord, waits for the operation to succeed
+// and returns the created resource.
+func (c Client) CreateDNSDomainRecordAndWait(ctx context.Context, domainID UUID, req CreateDNSDomainRecordRequest) (*DNSDomainRecord, error) {
+	op, err := c.CreateDNSDomainRecord(ctx, domainID, req)
 	if err != nil {
-		return nil, fmt.Errorf("CreateDNSDomainRecord: http client do: %w", err)
+		return nil, fmt.Errorf("CreateDNSDomainRecordAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateDNSDomainRecord: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("CreateDNSDomainRecordAndWait: %w", &OperationError{ID: op.ID, Err: err})
+	}
+	if done.Reference == nil {
+		return nil, fmt.Errorf("CreateDNSDomainRecordAndWait: %w", &OperationError{ID: op.ID, Err: ErrMissingOperationReference})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateDNSDomainRecord: prepare JSON response: %w", err)
+	resp, err := c.GetDNSDomainRecord(ctx, domainID, done.Reference.ID)
+	if err != nil {
+		return nil, fmt.Errorf("CreateDNSDomainRecordAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 // Delete DNS domain record
@@ -10806,30 +8121,9 @@ func (c Client) DeleteDNSDomainRecord(ctx context.Context, domainID UUID, record
 		return nil, fmt.Errorf("DeleteDNSDomainRecord: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDNSDomainRecord: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dns-domain-record")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteDNSDomainRecord: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteDNSDomainRecord: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteDNSDomainRecord: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-dns-domain-record", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDNSDomainRecord: %w", err)
 	}
 
 	return bodyresp, nil
@@ -10850,30 +8144,9 @@ func (c Client) GetDNSDomainRecord(ctx context.Context, domainID UUID, recordID
 		return nil, fmt.Errorf("GetDNSDomainRecord: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDNSDomainRecord: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dns-domain-record")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetDNSDomainRecord: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetDNSDomainRecord: http response: %w", err)
-	}
-
 	bodyresp := new(DNSDomainRecord)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetDNSDomainRecord: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-dns-domain-record", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDNSDomainRecord: %w", err)
 	}
 
 	return bodyresp, nil
@@ -10912,33 +8185,37 @@ func (c Client) UpdateDNSDomainRecord(ctx context.Context, domainID UUID, record
 		return nil, fmt.Errorf("UpdateDNSDomainRecord: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDNSDomainRecord: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "update-dns-domain-record", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateDNSDomainRecord: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "update-dns-domain-record")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// UpdateDNSDomainRecordAndWait calls UpdateDNSDomainRecord, waits for the operation to succeed
+// and returns the updated resource.
+func (c Client) UpdateDNSDomainRecordAndWait(ctx context.Context, domainID UUID, recordID UUID, req UpdateDNSDomainRecordRequest) (*DNSDomainRecord, error) {
+	op, err := c.UpdateDNSDomainRecord(ctx, domainID, recordID, req)
 	if err != nil {
-		return nil, fmt.Errorf("UpdateDNSDomainRecord: http client do: %w", err)
+		return nil, fmt.Errorf("UpdateDNSDomainRecordAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("UpdateDNSDomainRecord: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateDNSDomainRecordAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("UpdateDNSDomainRecord: prepare JSON response: %w", err)
+	resp, err := c.GetDNSDomainRecord(ctx, domainID, recordID)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateDNSDomainRecordAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 // Delete DNS Domain
@@ -10956,30 +8233,9 @@ func (c Client) DeleteDNSDomain(ctx context.Context, id UUID) (*Operation, error
 		return nil, fmt.Errorf("DeleteDNSDomain: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDNSDomain: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dns-domain")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteDNSDomain: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteDNSDomain: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteDNSDomain: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-dns-domain", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDNSDomain: %w", err)
 	}
 
 	return bodyresp, nil
@@ -11000,30 +8256,9 @@ func (c Client) GetDNSDomain(ctx context.Context, id UUID) (*DNSDomain, error) {
 		return nil, fmt.Errorf("GetDNSDomain: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDNSDomain: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dns-domain")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetDNSDomain: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetDNSDomain: http response: %w", err)
-	}
-
 	bodyresp := new(DNSDomain)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetDNSDomain: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-dns-domain", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDNSDomain: %w", err)
 	}
 
 	return bodyresp, nil
@@ -11048,30 +8283,9 @@ func (c Client) GetDNSDomainZoneFile(ctx context.Context, id UUID) (*GetDNSDomai
 		return nil, fmt.Errorf("GetDNSDomainZoneFile: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDNSDomainZoneFile: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dns-domain-zone-file")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetDNSDomainZoneFile: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetDNSDomainZoneFile: http response: %w", err)
-	}
-
 	bodyresp := new(GetDNSDomainZoneFileResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetDNSDomainZoneFile: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-dns-domain-zone-file", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDNSDomainZoneFile: %w", err)
 	}
 
 	return bodyresp, nil
@@ -11115,33 +8329,41 @@ func (c Client) ListElasticIPS(ctx context.Context) (*ListElasticIPSResponse, er
 		return nil, fmt.Errorf("ListElasticIPS: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListElasticIPS: sign request: %w", err)
+	bodyresp := new(ListElasticIPSResponse)
+	if err := c.do(ctx, "list-elastic-ips", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ListElasticIPS: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "list-elastic-ips")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ListElasticIPS: http client do: %w", err)
-	}
+// ListElasticIPSSeq returns an iterator over the elements listed by ListElasticIPS,
+// decoded while the response is read. The iteration stops at the first error,
+// yielded with a zero element, or once the context is done.
+func (c Client) ListElasticIPSSeq(ctx context.Context) iter.Seq2[ElasticIP, error] {
+	return func(yield func(ElasticIP, error) bool) {
+		path := "/elastic-ip"
 
-	if c.trace {
-		dumpResponse(response)
-	}
+		request, err := http.NewRequestWithContext(ctx, "GET", c.serverEndpoint+path, nil)
+		if err != nil {
+			yieldError(yield, fmt.Errorf("ListElasticIPSSeq: new request: %w", err))
+			return
+		}
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ListElasticIPS: http response: %w", err)
-	}
+		request.Header.Add("User-Agent", c.getUserAgent())
 
-	bodyresp := new(ListElasticIPSResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ListElasticIPS: prepare JSON response: %w", err)
-	}
+		if err := c.executeRequestInterceptors(ctx, request); err != nil {
+			yieldError(yield, fmt.Errorf("ListElasticIPSSeq: execute request editors: %w", err))
+			return
+		}
 
-	return bodyresp, nil
+		bodyresp := new(ListElasticIPSResponse)
+		call := &Call{OperationID: "list-elastic-ips", Request: request, Result: bodyresp}
+		elems := func() []ElasticIP { return bodyresp.ElasticIPS }
+		if err := streamList(ctx, c, call, true, "elastic-ips", elems, yield); err != nil {
+			yieldError(yield, fmt.Errorf("ListElasticIPSSeq: %w", err))
+		}
+	}
 }
 
 type CreateElasticIPRequestAddressfamily string
@@ -11183,33 +8405,40 @@ func (c Client) CreateElasticIP(ctx context.Context, req CreateElasticIPRequest)
 		return nil, fmt.Errorf("CreateElasticIP: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateElasticIP: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "create-elastic-ip", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateElasticIP: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "create-elastic-ip")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// CreateElasticIPAndWait calls CreateElasticIP, waits for the operation to succeed
+// and returns the created resource.
+func (c Client) CreateElasticIPAndWait(ctx context.Context, req CreateElasticIPRequest) (*ElasticIP, error) {
+	op, err := c.CreateElasticIP(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("CreateElasticIP: http client do: %w", err)
+		return nil, fmt.Errorf("CreateElasticIPAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateElasticIP: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("CreateElasticIPAndWait: %w", &OperationError{ID: op.ID, Err: err})
+	}
+	if done.Reference == nil {
+		return nil, fmt.Errorf("CreateElasticIPAndWait: %w", &OperationError{ID: op.ID, Err: ErrMissingOperationReference})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateElasticIP: prepare JSON response: %w", err)
+	resp, err := c.GetElasticIP(ctx, done.Reference.ID)
+	if err != nil {
+		return nil, fmt.Errorf("CreateElasticIPAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 // Delete an Elastic IP
@@ -11227,30 +8456,9 @@ func (c Client) DeleteElasticIP(ctx context.Context, id UUID) (*Operation, error
 		return nil, fmt.Errorf("DeleteElasticIP: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteElasticIP: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-elastic-ip")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteElasticIP: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteElasticIP: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteElasticIP: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-elastic-ip", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteElasticIP: %w", err)
 	}
 
 	return bodyresp, nil
@@ -11271,30 +8479,9 @@ func (c Client) GetElasticIP(ctx context.Context, id UUID) (*ElasticIP, error) {
 		return nil, fmt.Errorf("GetElasticIP: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetElasticIP: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-elastic-ip")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetElasticIP: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetElasticIP: http response: %w", err)
-	}
-
 	bodyresp := new(ElasticIP)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetElasticIP: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-elastic-ip", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetElasticIP: %w", err)
 	}
 
 	return bodyresp, nil
@@ -11330,33 +8517,37 @@ func (c Client) UpdateElasticIP(ctx context.Context, id UUID, req UpdateElasticI
 		return nil, fmt.Errorf("UpdateElasticIP: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateElasticIP: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "update-elastic-ip", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateElasticIP: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "update-elastic-ip")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// UpdateElasticIPAndWait calls UpdateElasticIP, waits for the operation to succeed
+// and returns the updated resource.
+func (c Client) UpdateElasticIPAndWait(ctx context.Context, id UUID, req UpdateElasticIPRequest) (*ElasticIP, error) {
+	op, err := c.UpdateElasticIP(ctx, id, req)
 	if err != nil {
-		return nil, fmt.Errorf("UpdateElasticIP: http client do: %w", err)
+		return nil, fmt.Errorf("UpdateElasticIPAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("UpdateElasticIP: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateElasticIPAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("UpdateElasticIP: prepare JSON response: %w", err)
+	resp, err := c.GetElasticIP(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateElasticIPAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 type ResetElasticIPFieldField string
@@ -11380,30 +8571,9 @@ func (c Client) ResetElasticIPField(ctx context.Context, id UUID, field ResetEla
 		return nil, fmt.Errorf("ResetElasticIPField: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetElasticIPField: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-elastic-ip-field")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ResetElasticIPField: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ResetElasticIPField: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ResetElasticIPField: prepare JSON response: %w", err)
+	if err := c.do(ctx, "reset-elastic-ip-field", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ResetElasticIPField: %w", err)
 	}
 
 	return bodyresp, nil
@@ -11436,30 +8606,9 @@ func (c Client) AttachInstanceToElasticIP(ctx context.Context, id UUID, req Atta
 		return nil, fmt.Errorf("AttachInstanceToElasticIP: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("AttachInstanceToElasticIP: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "attach-instance-to-elastic-ip")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("AttachInstanceToElasticIP: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("AttachInstanceToElasticIP: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("AttachInstanceToElasticIP: prepare JSON response: %w", err)
+	if err := c.do(ctx, "attach-instance-to-elastic-ip", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("AttachInstanceToElasticIP: %w", err)
 	}
 
 	return bodyresp, nil
@@ -11492,30 +8641,9 @@ func (c Client) DetachInstanceFromElasticIP(ctx context.Context, id UUID, req De
 		return nil, fmt.Errorf("DetachInstanceFromElasticIP: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DetachInstanceFromElasticIP: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "detach-instance-from-elastic-ip")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DetachInstanceFromElasticIP: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DetachInstanceFromElasticIP: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DetachInstanceFromElasticIP: prepare JSON response: %w", err)
+	if err := c.do(ctx, "detach-instance-from-elastic-ip", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DetachInstanceFromElasticIP: %w", err)
 	}
 
 	return bodyresp, nil
@@ -11536,30 +8664,9 @@ func (c Client) GetEnvImpact(ctx context.Context, period string) (*EnvImpactRepo
 		return nil, fmt.Errorf("GetEnvImpact: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetEnvImpact: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-env-impact")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetEnvImpact: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetEnvImpact: http response: %w", err)
-	}
-
 	bodyresp := new(EnvImpactReport)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetEnvImpact: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-env-impact", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetEnvImpact: %w", err)
 	}
 
 	return bodyresp, nil
@@ -11604,33 +8711,62 @@ func (c Client) ListEvents(ctx context.Context, opts ...ListEventsOpt) ([]Event,
 		return nil, fmt.Errorf("ListEvents: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListEvents: sign request: %w", err)
+	bodyresp := []Event{}
+	if err := c.do(ctx, "list-events", request, true, &bodyresp); err != nil {
+		return nil, fmt.Errorf("ListEvents: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "list-events")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ListEvents: http client do: %w", err)
-	}
+// ListEventsSeq returns an iterator over the elements listed by ListEvents,
+// decoded while the response is read. The iteration stops at the first error,
+// yielded with a zero element, or once the context is done.
+func (c Client) ListEventsSeq(ctx context.Context, opts ...ListEventsOpt) iter.Seq2[Event, error] {
+	return func(yield func(Event, error) bool) {
+		path := "/event"
 
-	if c.trace {
-		dumpResponse(response)
-	}
+		request, err := http.NewRequestWithContext(ctx, "GET", c.serverEndpoint+path, nil)
+		if err != nil {
+			yieldError(yield, fmt.Errorf("ListEventsSeq: new request: %w", err))
+			return
+		}
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ListEvents: http response: %w", err)
-	}
+		request.Header.Add("User-Agent", c.getUserAgent())
 
-	bodyresp := []Event{}
-	if err := prepareJSONResponse(response, &bodyresp); err != nil {
-		return nil, fmt.Errorf("ListEvents: prepare JSON response: %w", err)
+		if len(opts) > 0 {
+			q := request.URL.Query()
+			for _, opt := range opts {
+				opt(q)
+			}
+			request.URL.RawQuery = q.Encode()
+		}
+
+		if err := c.executeRequestInterceptors(ctx, request); err != nil {
+			yieldError(yield, fmt.Errorf("ListEventsSeq: execute request editors: %w", err))
+			return
+		}
+
+		bodyresp := []Event{}
+		call := &Call{OperationID: "list-events", Request: request, Result: &bodyresp}
+		elems := func() []Event { return bodyresp }
+		if err := streamList(ctx, c, call, true, "", elems, yield); err != nil {
+			yieldError(yield, fmt.Errorf("ListEventsSeq: %w", err))
+		}
 	}
+}
 
-	return bodyresp, nil
+// ListEventsWindowSeq returns an iterator over the elements listed by ListEvents between from and to,
+// by windows of the given duration in a request each, e.g. to list long periods.
+// The iteration stops at the first error, yielded with a zero element, or once the context is done.
+func (c Client) ListEventsWindowSeq(ctx context.Context, from, to time.Time, window time.Duration, opts ...ListEventsOpt) iter.Seq2[Event, error] {
+	return windowSeq(ctx, from, to, window,
+		func(v Event) time.Time { return v.Timestamp },
+		func(ctx context.Context, from, to time.Time) iter.Seq2[Event, error] {
+			windowOpts := append(append([]ListEventsOpt{}, opts...), ListEventsWithFrom(from), ListEventsWithTo(to))
+			return c.ListEventsSeq(ctx, windowOpts...)
+		},
+	)
 }
 
 // Retrieve IAM Organization Policy
@@ -11648,30 +8784,9 @@ func (c Client) GetIAMOrganizationPolicy(ctx context.Context) (*IAMPolicy, error
 		return nil, fmt.Errorf("GetIAMOrganizationPolicy: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetIAMOrganizationPolicy: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-iam-organization-policy")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetIAMOrganizationPolicy: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetIAMOrganizationPolicy: http response: %w", err)
-	}
-
 	bodyresp := new(IAMPolicy)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetIAMOrganizationPolicy: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-iam-organization-policy", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetIAMOrganizationPolicy: %w", err)
 	}
 
 	return bodyresp, nil
@@ -11699,33 +8814,37 @@ func (c Client) UpdateIAMOrganizationPolicy(ctx context.Context, req IAMPolicy)
 		return nil, fmt.Errorf("UpdateIAMOrganizationPolicy: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateIAMOrganizationPolicy: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "update-iam-organization-policy", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateIAMOrganizationPolicy: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "update-iam-organization-policy")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// UpdateIAMOrganizationPolicyAndWait calls UpdateIAMOrganizationPolicy, waits for the operation to succeed
+// and returns the updated resource.
+func (c Client) UpdateIAMOrganizationPolicyAndWait(ctx context.Context, req IAMPolicy) (*IAMPolicy, error) {
+	op, err := c.UpdateIAMOrganizationPolicy(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("UpdateIAMOrganizationPolicy: http client do: %w", err)
+		return nil, fmt.Errorf("UpdateIAMOrganizationPolicyAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("UpdateIAMOrganizationPolicy: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateIAMOrganizationPolicyAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("UpdateIAMOrganizationPolicy: prepare JSON response: %w", err)
+	resp, err := c.GetIAMOrganizationPolicy(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateIAMOrganizationPolicyAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 // Reset IAM Organization Policy
@@ -11743,30 +8862,9 @@ func (c Client) ResetIAMOrganizationPolicy(ctx context.Context) (*Operation, err
 		return nil, fmt.Errorf("ResetIAMOrganizationPolicy: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetIAMOrganizationPolicy: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-iam-organization-policy")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ResetIAMOrganizationPolicy: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ResetIAMOrganizationPolicy: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ResetIAMOrganizationPolicy: prepare JSON response: %w", err)
+	if err := c.do(ctx, "reset-iam-organization-policy", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ResetIAMOrganizationPolicy: %w", err)
 	}
 
 	return bodyresp, nil
@@ -11810,33 +8908,41 @@ func (c Client) ListIAMRoles(ctx context.Context) (*ListIAMRolesResponse, error)
 		return nil, fmt.Errorf("ListIAMRoles: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListIAMRoles: sign request: %w", err)
+	bodyresp := new(ListIAMRolesResponse)
+	if err := c.do(ctx, "list-iam-roles", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ListIAMRoles: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "list-iam-roles")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ListIAMRoles: http client do: %w", err)
-	}
+// ListIAMRolesSeq returns an iterator over the elements listed by ListIAMRoles,
+// decoded while the response is read. The iteration stops at the first error,
+// yielded with a zero element, or once the context is done.
+func (c Client) ListIAMRolesSeq(ctx context.Context) iter.Seq2[IAMRole, error] {
+	return func(yield func(IAMRole, error) bool) {
+		path := "/iam-role"
 
-	if c.trace {
-		dumpResponse(response)
-	}
+		request, err := http.NewRequestWithContext(ctx, "GET", c.serverEndpoint+path, nil)
+		if err != nil {
+			yieldError(yield, fmt.Errorf("ListIAMRolesSeq: new request: %w", err))
+			return
+		}
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ListIAMRoles: http response: %w", err)
-	}
+		request.Header.Add("User-Agent", c.getUserAgent())
 
-	bodyresp := new(ListIAMRolesResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ListIAMRoles: prepare JSON response: %w", err)
-	}
+		if err := c.executeRequestInterceptors(ctx, request); err != nil {
+			yieldError(yield, fmt.Errorf("ListIAMRolesSeq: execute request editors: %w", err))
+			return
+		}
 
-	return bodyresp, nil
+		bodyresp := new(ListIAMRolesResponse)
+		call := &Call{OperationID: "list-iam-roles", Request: request, Result: bodyresp}
+		elems := func() []IAMRole { return bodyresp.IAMRoles }
+		if err := streamList(ctx, c, call, true, "iam-roles", elems, yield); err != nil {
+			yieldError(yield, fmt.Errorf("ListIAMRolesSeq: %w", err))
+		}
+	}
 }
 
 type CreateIAMRoleRequest struct {
@@ -11879,74 +8985,60 @@ func (c Client) CreateIAMRole(ctx context.Context, req CreateIAMRoleRequest) (*O
 		return nil, fmt.Errorf("CreateIAMRole: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateIAMRole: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "create-iam-role", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateIAMRole: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "create-iam-role")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// CreateIAMRoleAndWait calls CreateIAMRole, waits for the operation to succeed
+// and returns the created resource.
+func (c Client) CreateIAMRoleAndWait(ctx context.Context, req CreateIAMRoleRequest) (*IAMRole, error) {
+	op, err := c.CreateIAMRole(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("CreateIAMRole: http client do: %w", err)
+		return nil, fmt.Errorf("CreateIAMRoleAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateIAMRole: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("CreateIAMRoleAndWait: %w", &OperationError{ID: op.ID, Err: err})
+	}
+	if done.Reference == nil {
+		return nil, fmt.Errorf("CreateIAMRoleAndWait: %w", &OperationError{ID: op.ID, Err: ErrMissingOperationReference})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateIAMRole: prepare JSON response: %w", err)
+	resp, err := c.GetIAMRole(ctx, done.Reference.ID)
+	if err != nil {
+		return nil, fmt.Errorf("CreateIAMRoleAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 // Delete IAM Role
 func (c Client) DeleteIAMRole(ctx context.Context, id UUID) (*Operation, error) {
-	path := fmt.Sprintf("/iam-role/%v", id)
-
-	request, err := http.NewRequestWithContext(ctx, "DELETE", c.serverEndpoint+path, nil)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteIAMRole: new request: %w", err)
-	}
-
-	request.Header.Add("User-Agent", c.getUserAgent())
-
-	if err := c.executeRequestInterceptors(ctx, request); err != nil {
-		return nil, fmt.Errorf("DeleteIAMRole: execute request editors: %w", err)
-	}
-
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteIAMRole: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-iam-role")
-	}
+	path := fmt.Sprintf("/iam-role/%v", id)
 
-	response, err := c.httpClient.Do(request)
+	request, err := http.NewRequestWithContext(ctx, "DELETE", c.serverEndpoint+path, nil)
 	if err != nil {
-		return nil, fmt.Errorf("DeleteIAMRole: http client do: %w", err)
+		return nil, fmt.Errorf("DeleteIAMRole: new request: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
+	request.Header.Add("User-Agent", c.getUserAgent())
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteIAMRole: http response: %w", err)
+	if err := c.executeRequestInterceptors(ctx, request); err != nil {
+		return nil, fmt.Errorf("DeleteIAMRole: execute request editors: %w", err)
 	}
 
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteIAMRole: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-iam-role", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteIAMRole: %w", err)
 	}
 
 	return bodyresp, nil
@@ -11967,30 +9059,9 @@ func (c Client) GetIAMRole(ctx context.Context, id UUID) (*IAMRole, error) {
 		return nil, fmt.Errorf("GetIAMRole: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetIAMRole: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-iam-role")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetIAMRole: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetIAMRole: http response: %w", err)
-	}
-
 	bodyresp := new(IAMRole)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetIAMRole: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-iam-role", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetIAMRole: %w", err)
 	}
 
 	return bodyresp, nil
@@ -12030,33 +9101,37 @@ func (c Client) UpdateIAMRole(ctx context.Context, id UUID, req UpdateIAMRoleReq
 		return nil, fmt.Errorf("UpdateIAMRole: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateIAMRole: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "update-iam-role", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateIAMRole: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "update-iam-role")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// UpdateIAMRoleAndWait calls UpdateIAMRole, waits for the operation to succeed
+// and returns the updated resource.
+func (c Client) UpdateIAMRoleAndWait(ctx context.Context, id UUID, req UpdateIAMRoleRequest) (*IAMRole, error) {
+	op, err := c.UpdateIAMRole(ctx, id, req)
 	if err != nil {
-		return nil, fmt.Errorf("UpdateIAMRole: http client do: %w", err)
+		return nil, fmt.Errorf("UpdateIAMRoleAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("UpdateIAMRole: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateIAMRoleAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("UpdateIAMRole: prepare JSON response: %w", err)
+	resp, err := c.GetIAMRole(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateIAMRoleAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 type AssumeIAMRoleResponse struct {
@@ -12095,30 +9170,9 @@ func (c Client) AssumeIAMRole(ctx context.Context, id UUID, req AssumeIAMRoleReq
 		return nil, fmt.Errorf("AssumeIAMRole: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("AssumeIAMRole: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "assume-iam-role")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("AssumeIAMRole: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("AssumeIAMRole: http response: %w", err)
-	}
-
 	bodyresp := new(AssumeIAMRoleResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("AssumeIAMRole: prepare JSON response: %w", err)
+	if err := c.do(ctx, "assume-iam-role", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("AssumeIAMRole: %w", err)
 	}
 
 	return bodyresp, nil
@@ -12146,30 +9200,9 @@ func (c Client) UpdateIAMRolePolicy(ctx context.Context, id UUID, req IAMPolicy)
 		return nil, fmt.Errorf("UpdateIAMRolePolicy: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateIAMRolePolicy: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-iam-role-policy")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("UpdateIAMRolePolicy: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("UpdateIAMRolePolicy: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("UpdateIAMRolePolicy: prepare JSON response: %w", err)
+	if err := c.do(ctx, "update-iam-role-policy", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateIAMRolePolicy: %w", err)
 	}
 
 	return bodyresp, nil
@@ -12294,33 +9327,49 @@ func (c Client) ListInstances(ctx context.Context, opts ...ListInstancesOpt) (*L
 		return nil, fmt.Errorf("ListInstances: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListInstances: sign request: %w", err)
+	bodyresp := new(ListInstancesResponse)
+	if err := c.do(ctx, "list-instances", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ListInstances: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "list-instances")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ListInstances: http client do: %w", err)
-	}
+// ListInstancesSeq returns an iterator over the elements listed by ListInstances,
+// decoded while the response is read. The iteration stops at the first error,
+// yielded with a zero element, or once the context is done.
+func (c Client) ListInstancesSeq(ctx context.Context, opts ...ListInstancesOpt) iter.Seq2[ListInstancesResponseInstances, error] {
+	return func(yield func(ListInstancesResponseInstances, error) bool) {
+		path := "/instance"
 
-	if c.trace {
-		dumpResponse(response)
-	}
+		request, err := http.NewRequestWithContext(ctx, "GET", c.serverEndpoint+path, nil)
+		if err != nil {
+			yieldError(yield, fmt.Errorf("ListInstancesSeq: new request: %w", err))
+			return
+		}
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ListInstances: http response: %w", err)
-	}
+		request.Header.Add("User-Agent", c.getUserAgent())
 
-	bodyresp := new(ListInstancesResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ListInstances: prepare JSON response: %w", err)
-	}
+		if len(opts) > 0 {
+			q := request.URL.Query()
+			for _, opt := range opts {
+				opt(q)
+			}
+			request.URL.RawQuery = q.Encode()
+		}
 
-	return bodyresp, nil
+		if err := c.executeRequestInterceptors(ctx, request); err != nil {
+			yieldError(yield, fmt.Errorf("ListInstancesSeq: execute request editors: %w", err))
+			return
+		}
+
+		bodyresp := new(ListInstancesResponse)
+		call := &Call{OperationID: "list-instances", Request: request, Result: bodyresp}
+		elems := func() []ListInstancesResponseInstances { return bodyresp.Instances }
+		if err := streamList(ctx, c, call, true, "instances", elems, yield); err != nil {
+			yieldError(yield, fmt.Errorf("ListInstancesSeq: %w", err))
+		}
+	}
 }
 
 type CreateInstanceRequest struct {
@@ -12380,33 +9429,40 @@ func (c Client) CreateInstance(ctx context.Context, req CreateInstanceRequest) (
 		return nil, fmt.Errorf("CreateInstance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateInstance: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "create-instance", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateInstance: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "create-instance")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// CreateInstanceAndWait calls CreateInstance, waits for the operation to succeed
+// and returns the created resource.
+func (c Client) CreateInstanceAndWait(ctx context.Context, req CreateInstanceRequest) (*Instance, error) {
+	op, err := c.CreateInstance(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("CreateInstance: http client do: %w", err)
+		return nil, fmt.Errorf("CreateInstanceAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateInstance: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("CreateInstanceAndWait: %w", &OperationError{ID: op.ID, Err: err})
+	}
+	if done.Reference == nil {
+		return nil, fmt.Errorf("CreateInstanceAndWait: %w", &OperationError{ID: op.ID, Err: ErrMissingOperationReference})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateInstance: prepare JSON response: %w", err)
+	resp, err := c.GetInstance(ctx, done.Reference.ID)
+	if err != nil {
+		return nil, fmt.Errorf("CreateInstanceAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 type ListInstancePoolsResponse struct {
@@ -12447,33 +9503,41 @@ func (c Client) ListInstancePools(ctx context.Context) (*ListInstancePoolsRespon
 		return nil, fmt.Errorf("ListInstancePools: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListInstancePools: sign request: %w", err)
+	bodyresp := new(ListInstancePoolsResponse)
+	if err := c.do(ctx, "list-instance-pools", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ListInstancePools: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "list-instance-pools")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ListInstancePools: http client do: %w", err)
-	}
+// ListInstancePoolsSeq returns an iterator over the elements listed by ListInstancePools,
+// decoded while the response is read. The iteration stops at the first error,
+// yielded with a zero element, or once the context is done.
+func (c Client) ListInstancePoolsSeq(ctx context.Context) iter.Seq2[InstancePool, error] {
+	return func(yield func(InstancePool, error) bool) {
+		path := "/instance-pool"
 
-	if c.trace {
-		dumpResponse(response)
-	}
+		request, err := http.NewRequestWithContext(ctx, "GET", c.serverEndpoint+path, nil)
+		if err != nil {
+			yieldError(yield, fmt.Errorf("ListInstancePoolsSeq: new request: %w", err))
+			return
+		}
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ListInstancePools: http response: %w", err)
-	}
+		request.Header.Add("User-Agent", c.getUserAgent())
 
-	bodyresp := new(ListInstancePoolsResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ListInstancePools: prepare JSON response: %w", err)
-	}
+		if err := c.executeRequestInterceptors(ctx, request); err != nil {
+			yieldError(yield, fmt.Errorf("ListInstancePoolsSeq: execute request editors: %w", err))
+			return
+		}
 
-	return bodyresp, nil
+		bodyresp := new(ListInstancePoolsResponse)
+		call := &Call{OperationID: "list-instance-pools", Request: request, Result: bodyresp}
+		elems := func() []InstancePool { return bodyresp.InstancePools }
+		if err := streamList(ctx, c, call, true, "instance-pools", elems, yield); err != nil {
+			yieldError(yield, fmt.Errorf("ListInstancePoolsSeq: %w", err))
+		}
+	}
 }
 
 type CreateInstancePoolRequestPublicIPAssignment string
@@ -12548,33 +9612,40 @@ func (c Client) CreateInstancePool(ctx context.Context, req CreateInstancePoolRe
 		return nil, fmt.Errorf("CreateInstancePool: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateInstancePool: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "create-instance-pool", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateInstancePool: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "create-instance-pool")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// CreateInstancePoolAndWait calls CreateInstancePool, waits for the operation to succeed
+// and returns the created resource.
+func (c Client) CreateInstancePoolAndWait(ctx context.Context, req CreateInstancePoolRequest) (*InstancePool, error) {
+	op, err := c.CreateInstancePool(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("CreateInstancePool: http client do: %w", err)
+		return nil, fmt.Errorf("CreateInstancePoolAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateInstancePool: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("CreateInstancePoolAndWait: %w", &OperationError{ID: op.ID, Err: err})
+	}
+	if done.Reference == nil {
+		return nil, fmt.Errorf("CreateInstancePoolAndWait: %w", &OperationError{ID: op.ID, Err: ErrMissingOperationReference})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateInstancePool: prepare JSON response: %w", err)
+	resp, err := c.GetInstancePool(ctx, done.Reference.ID)
+	if err != nil {
+		return nil, fmt.Errorf("CreateInstancePoolAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 // Delete an Instance Pool
@@ -12592,30 +9663,9 @@ func (c Client) DeleteInstancePool(ctx context.Context, id UUID) (*Operation, er
 		return nil, fmt.Errorf("DeleteInstancePool: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteInstancePool: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-instance-pool")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteInstancePool: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteInstancePool: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteInstancePool: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-instance-pool", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteInstancePool: %w", err)
 	}
 
 	return bodyresp, nil
@@ -12636,30 +9686,9 @@ func (c Client) GetInstancePool(ctx context.Context, id UUID) (*InstancePool, er
 		return nil, fmt.Errorf("GetInstancePool: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetInstancePool: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-instance-pool")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetInstancePool: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetInstancePool: http response: %w", err)
-	}
-
 	bodyresp := new(InstancePool)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetInstancePool: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-instance-pool", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetInstancePool: %w", err)
 	}
 
 	return bodyresp, nil
@@ -12734,33 +9763,37 @@ func (c Client) UpdateInstancePool(ctx context.Context, id UUID, req UpdateInsta
 		return nil, fmt.Errorf("UpdateInstancePool: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateInstancePool: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "update-instance-pool", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateInstancePool: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "update-instance-pool")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// UpdateInstancePoolAndWait calls UpdateInstancePool, waits for the operation to succeed
+// and returns the updated resource.
+func (c Client) UpdateInstancePoolAndWait(ctx context.Context, id UUID, req UpdateInstancePoolRequest) (*InstancePool, error) {
+	op, err := c.UpdateInstancePool(ctx, id, req)
 	if err != nil {
-		return nil, fmt.Errorf("UpdateInstancePool: http client do: %w", err)
+		return nil, fmt.Errorf("UpdateInstancePoolAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("UpdateInstancePool: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateInstancePoolAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("UpdateInstancePool: prepare JSON response: %w", err)
+	resp, err := c.GetInstancePool(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateInstancePoolAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 type ResetInstancePoolFieldField string
@@ -12793,30 +9826,9 @@ func (c Client) ResetInstancePoolField(ctx context.Context, id UUID, field Reset
 		return nil, fmt.Errorf("ResetInstancePoolField: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetInstancePoolField: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-instance-pool-field")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ResetInstancePoolField: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ResetInstancePoolField: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ResetInstancePoolField: prepare JSON response: %w", err)
+	if err := c.do(ctx, "reset-instance-pool-field", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ResetInstancePoolField: %w", err)
 	}
 
 	return bodyresp, nil
@@ -12848,30 +9860,9 @@ func (c Client) EvictInstancePoolMembers(ctx context.Context, id UUID, req Evict
 		return nil, fmt.Errorf("EvictInstancePoolMembers: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("EvictInstancePoolMembers: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "evict-instance-pool-members")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("EvictInstancePoolMembers: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("EvictInstancePoolMembers: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("EvictInstancePoolMembers: prepare JSON response: %w", err)
+	if err := c.do(ctx, "evict-instance-pool-members", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("EvictInstancePoolMembers: %w", err)
 	}
 
 	return bodyresp, nil
@@ -12904,30 +9895,9 @@ func (c Client) ScaleInstancePool(ctx context.Context, id UUID, req ScaleInstanc
 		return nil, fmt.Errorf("ScaleInstancePool: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ScaleInstancePool: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "scale-instance-pool")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ScaleInstancePool: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ScaleInstancePool: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ScaleInstancePool: prepare JSON response: %w", err)
+	if err := c.do(ctx, "scale-instance-pool", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ScaleInstancePool: %w", err)
 	}
 
 	return bodyresp, nil
@@ -12971,33 +9941,41 @@ func (c Client) ListInstanceTypes(ctx context.Context) (*ListInstanceTypesRespon
 		return nil, fmt.Errorf("ListInstanceTypes: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListInstanceTypes: sign request: %w", err)
+	bodyresp := new(ListInstanceTypesResponse)
+	if err := c.do(ctx, "list-instance-types", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ListInstanceTypes: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "list-instance-types")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ListInstanceTypes: http client do: %w", err)
-	}
+// ListInstanceTypesSeq returns an iterator over the elements listed by ListInstanceTypes,
+// decoded while the response is read. The iteration stops at the first error,
+// yielded with a zero element, or once the context is done.
+func (c Client) ListInstanceTypesSeq(ctx context.Context) iter.Seq2[InstanceType, error] {
+	return func(yield func(InstanceType, error) bool) {
+		path := "/instance-type"
 
-	if c.trace {
-		dumpResponse(response)
-	}
+		request, err := http.NewRequestWithContext(ctx, "GET", c.serverEndpoint+path, nil)
+		if err != nil {
+			yieldError(yield, fmt.Errorf("ListInstanceTypesSeq: new request: %w", err))
+			return
+		}
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ListInstanceTypes: http response: %w", err)
-	}
+		request.Header.Add("User-Agent", c.getUserAgent())
 
-	bodyresp := new(ListInstanceTypesResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ListInstanceTypes: prepare JSON response: %w", err)
-	}
+		if err := c.executeRequestInterceptors(ctx, request); err != nil {
+			yieldError(yield, fmt.Errorf("ListInstanceTypesSeq: execute request editors: %w", err))
+			return
+		}
 
-	return bodyresp, nil
+		bodyresp := new(ListInstanceTypesResponse)
+		call := &Call{OperationID: "list-instance-types", Request: request, Result: bodyresp}
+		elems := func() []InstanceType { return bodyresp.InstanceTypes }
+		if err := streamList(ctx, c, call, true, "instance-types", elems, yield); err != nil {
+			yieldError(yield, fmt.Errorf("ListInstanceTypesSeq: %w", err))
+		}
+	}
 }
 
 // Retrieve Instance Type details
@@ -13015,30 +9993,9 @@ func (c Client) GetInstanceType(ctx context.Context, id UUID) (*InstanceType, er
 		return nil, fmt.Errorf("GetInstanceType: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetInstanceType: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-instance-type")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetInstanceType: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetInstanceType: http response: %w", err)
-	}
-
 	bodyresp := new(InstanceType)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetInstanceType: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-instance-type", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetInstanceType: %w", err)
 	}
 
 	return bodyresp, nil
@@ -13059,30 +10016,9 @@ func (c Client) DeleteInstance(ctx context.Context, id UUID) (*Operation, error)
 		return nil, fmt.Errorf("DeleteInstance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteInstance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-instance")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteInstance: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteInstance: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteInstance: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-instance", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteInstance: %w", err)
 	}
 
 	return bodyresp, nil
@@ -13103,30 +10039,9 @@ func (c Client) GetInstance(ctx context.Context, id UUID) (*Instance, error) {
 		return nil, fmt.Errorf("GetInstance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetInstance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-instance")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetInstance: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetInstance: http response: %w", err)
-	}
-
 	bodyresp := new(Instance)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetInstance: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-instance", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetInstance: %w", err)
 	}
 
 	return bodyresp, nil
@@ -13165,33 +10080,37 @@ func (c Client) UpdateInstance(ctx context.Context, id UUID, req UpdateInstanceR
 		return nil, fmt.Errorf("UpdateInstance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateInstance: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "update-instance", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateInstance: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "update-instance")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// UpdateInstanceAndWait calls UpdateInstance, waits for the operation to succeed
+// and returns the updated resource.
+func (c Client) UpdateInstanceAndWait(ctx context.Context, id UUID, req UpdateInstanceRequest) (*Instance, error) {
+	op, err := c.UpdateInstance(ctx, id, req)
 	if err != nil {
-		return nil, fmt.Errorf("UpdateInstance: http client do: %w", err)
+		return nil, fmt.Errorf("UpdateInstanceAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("UpdateInstance: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateInstanceAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("UpdateInstance: prepare JSON response: %w", err)
+	resp, err := c.GetInstance(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateInstanceAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 type ResetInstanceFieldField string
@@ -13215,30 +10134,9 @@ func (c Client) ResetInstanceField(ctx context.Context, id UUID, field ResetInst
 		return nil, fmt.Errorf("ResetInstanceField: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetInstanceField: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-instance-field")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ResetInstanceField: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ResetInstanceField: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ResetInstanceField: prepare JSON response: %w", err)
+	if err := c.do(ctx, "reset-instance-field", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ResetInstanceField: %w", err)
 	}
 
 	return bodyresp, nil
@@ -13259,30 +10157,9 @@ func (c Client) AddInstanceProtection(ctx context.Context, id UUID) (*Operation,
 		return nil, fmt.Errorf("AddInstanceProtection: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("AddInstanceProtection: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "add-instance-protection")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("AddInstanceProtection: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("AddInstanceProtection: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("AddInstanceProtection: prepare JSON response: %w", err)
+	if err := c.do(ctx, "add-instance-protection", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("AddInstanceProtection: %w", err)
 	}
 
 	return bodyresp, nil
@@ -13300,33 +10177,12 @@ func (c Client) CreateSnapshot(ctx context.Context, id UUID) (*Operation, error)
 	request.Header.Add("User-Agent", c.getUserAgent())
 
 	if err := c.executeRequestInterceptors(ctx, request); err != nil {
-		return nil, fmt.Errorf("CreateSnapshot: execute request editors: %w", err)
-	}
-
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateSnapshot: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-snapshot")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("CreateSnapshot: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateSnapshot: http response: %w", err)
+		return nil, fmt.Errorf("CreateSnapshot: execute request editors: %w", err)
 	}
 
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateSnapshot: prepare JSON response: %w", err)
+	if err := c.do(ctx, "create-snapshot", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateSnapshot: %w", err)
 	}
 
 	return bodyresp, nil
@@ -13347,30 +10203,9 @@ func (c Client) EnableTpm(ctx context.Context, id UUID) (*Operation, error) {
 		return nil, fmt.Errorf("EnableTpm: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("EnableTpm: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "enable-tpm")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("EnableTpm: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("EnableTpm: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("EnableTpm: prepare JSON response: %w", err)
+	if err := c.do(ctx, "enable-tpm", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("EnableTpm: %w", err)
 	}
 
 	return bodyresp, nil
@@ -13395,30 +10230,9 @@ func (c Client) RevealInstancePassword(ctx context.Context, id UUID) (*InstanceP
 		return nil, fmt.Errorf("RevealInstancePassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RevealInstancePassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reveal-instance-password")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("RevealInstancePassword: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("RevealInstancePassword: http response: %w", err)
-	}
-
 	bodyresp := new(InstancePassword)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("RevealInstancePassword: prepare JSON response: %w", err)
+	if err := c.do(ctx, "reveal-instance-password", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("RevealInstancePassword: %w", err)
 	}
 
 	return bodyresp, nil
@@ -13439,30 +10253,9 @@ func (c Client) RebootInstance(ctx context.Context, id UUID) (*Operation, error)
 		return nil, fmt.Errorf("RebootInstance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RebootInstance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reboot-instance")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("RebootInstance: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("RebootInstance: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("RebootInstance: prepare JSON response: %w", err)
+	if err := c.do(ctx, "reboot-instance", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("RebootInstance: %w", err)
 	}
 
 	return bodyresp, nil
@@ -13483,30 +10276,9 @@ func (c Client) RemoveInstanceProtection(ctx context.Context, id UUID) (*Operati
 		return nil, fmt.Errorf("RemoveInstanceProtection: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RemoveInstanceProtection: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "remove-instance-protection")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("RemoveInstanceProtection: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("RemoveInstanceProtection: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("RemoveInstanceProtection: prepare JSON response: %w", err)
+	if err := c.do(ctx, "remove-instance-protection", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("RemoveInstanceProtection: %w", err)
 	}
 
 	return bodyresp, nil
@@ -13541,30 +10313,9 @@ func (c Client) ResetInstance(ctx context.Context, id UUID, req ResetInstanceReq
 		return nil, fmt.Errorf("ResetInstance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetInstance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-instance")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ResetInstance: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ResetInstance: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ResetInstance: prepare JSON response: %w", err)
+	if err := c.do(ctx, "reset-instance", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ResetInstance: %w", err)
 	}
 
 	return bodyresp, nil
@@ -13585,30 +10336,9 @@ func (c Client) ResetInstancePassword(ctx context.Context, id UUID) (*Operation,
 		return nil, fmt.Errorf("ResetInstancePassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetInstancePassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-instance-password")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ResetInstancePassword: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ResetInstancePassword: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ResetInstancePassword: prepare JSON response: %w", err)
+	if err := c.do(ctx, "reset-instance-password", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ResetInstancePassword: %w", err)
 	}
 
 	return bodyresp, nil
@@ -13641,30 +10371,9 @@ func (c Client) ResizeInstanceDisk(ctx context.Context, id UUID, req ResizeInsta
 		return nil, fmt.Errorf("ResizeInstanceDisk: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResizeInstanceDisk: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "resize-instance-disk")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ResizeInstanceDisk: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ResizeInstanceDisk: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ResizeInstanceDisk: prepare JSON response: %w", err)
+	if err := c.do(ctx, "resize-instance-disk", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ResizeInstanceDisk: %w", err)
 	}
 
 	return bodyresp, nil
@@ -13697,30 +10406,9 @@ func (c Client) ScaleInstance(ctx context.Context, id UUID, req ScaleInstanceReq
 		return nil, fmt.Errorf("ScaleInstance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ScaleInstance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "scale-instance")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ScaleInstance: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ScaleInstance: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ScaleInstance: prepare JSON response: %w", err)
+	if err := c.do(ctx, "scale-instance", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ScaleInstance: %w", err)
 	}
 
 	return bodyresp, nil
@@ -13760,30 +10448,9 @@ func (c Client) StartInstance(ctx context.Context, id UUID, req StartInstanceReq
 		return nil, fmt.Errorf("StartInstance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("StartInstance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "start-instance")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("StartInstance: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("StartInstance: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("StartInstance: prepare JSON response: %w", err)
+	if err := c.do(ctx, "start-instance", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("StartInstance: %w", err)
 	}
 
 	return bodyresp, nil
@@ -13804,30 +10471,9 @@ func (c Client) StopInstance(ctx context.Context, id UUID) (*Operation, error) {
 		return nil, fmt.Errorf("StopInstance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("StopInstance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "stop-instance")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("StopInstance: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("StopInstance: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("StopInstance: prepare JSON response: %w", err)
+	if err := c.do(ctx, "stop-instance", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("StopInstance: %w", err)
 	}
 
 	return bodyresp, nil
@@ -13861,30 +10507,9 @@ func (c Client) RevertInstanceToSnapshot(ctx context.Context, instanceID UUID, r
 		return nil, fmt.Errorf("RevertInstanceToSnapshot: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RevertInstanceToSnapshot: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "revert-instance-to-snapshot")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("RevertInstanceToSnapshot: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("RevertInstanceToSnapshot: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("RevertInstanceToSnapshot: prepare JSON response: %w", err)
+	if err := c.do(ctx, "revert-instance-to-snapshot", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("RevertInstanceToSnapshot: %w", err)
 	}
 
 	return bodyresp, nil
@@ -13924,33 +10549,41 @@ func (c Client) ListKmsKeys(ctx context.Context) (*ListKmsKeysResponse, error) {
 		return nil, fmt.Errorf("ListKmsKeys: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListKmsKeys: sign request: %w", err)
+	bodyresp := new(ListKmsKeysResponse)
+	if err := c.do(ctx, "list-kms-keys", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ListKmsKeys: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "list-kms-keys")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ListKmsKeys: http client do: %w", err)
-	}
+// ListKmsKeysSeq returns an iterator over the elements listed by ListKmsKeys,
+// decoded while the response is read. The iteration stops at the first error,
+// yielded with a zero element, or once the context is done.
+func (c Client) ListKmsKeysSeq(ctx context.Context) iter.Seq2[ListKmsKeysResponseEntry, error] {
+	return func(yield func(ListKmsKeysResponseEntry, error) bool) {
+		path := "/kms-key"
 
-	if c.trace {
-		dumpResponse(response)
-	}
+		request, err := http.NewRequestWithContext(ctx, "GET", c.serverEndpoint+path, nil)
+		if err != nil {
+			yieldError(yield, fmt.Errorf("ListKmsKeysSeq: new request: %w", err))
+			return
+		}
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ListKmsKeys: http response: %w", err)
-	}
+		request.Header.Add("User-Agent", c.getUserAgent())
 
-	bodyresp := new(ListKmsKeysResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ListKmsKeys: prepare JSON response: %w", err)
-	}
+		if err := c.executeRequestInterceptors(ctx, request); err != nil {
+			yieldError(yield, fmt.Errorf("ListKmsKeysSeq: execute request editors: %w", err))
+			return
+		}
 
-	return bodyresp, nil
+		bodyresp := new(ListKmsKeysResponse)
+		call := &Call{OperationID: "list-kms-keys", Request: request, Result: bodyresp}
+		elems := func() []ListKmsKeysResponseEntry { return bodyresp.KmsKeys }
+		if err := streamList(ctx, c, call, true, "kms-keys", elems, yield); err != nil {
+			yieldError(yield, fmt.Errorf("ListKmsKeysSeq: %w", err))
+		}
+	}
 }
 
 // Create a customer-managed unique KMS Key in your organization. A KMS Key is a logical representation of a cryptographic key material. It also includes metadata such as a UUID, a name and its state.
@@ -13975,30 +10608,9 @@ func (c Client) CreateKmsKey(ctx context.Context, req CreateKmsKeyRequest) (*Cre
 		return nil, fmt.Errorf("CreateKmsKey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateKmsKey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-kms-key")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("CreateKmsKey: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateKmsKey: http response: %w", err)
-	}
-
 	bodyresp := new(CreateKmsKeyResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateKmsKey: prepare JSON response: %w", err)
+	if err := c.do(ctx, "create-kms-key", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateKmsKey: %w", err)
 	}
 
 	return bodyresp, nil
@@ -14019,30 +10631,9 @@ func (c Client) GetKmsKey(ctx context.Context, id UUID) (*GetKmsKeyResponse, err
 		return nil, fmt.Errorf("GetKmsKey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetKmsKey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-kms-key")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetKmsKey: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetKmsKey: http response: %w", err)
-	}
-
 	bodyresp := new(GetKmsKeyResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetKmsKey: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-kms-key", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetKmsKey: %w", err)
 	}
 
 	return bodyresp, nil
@@ -14063,30 +10654,9 @@ func (c Client) CancelKmsKeyDeletion(ctx context.Context, id UUID) (*SuccessResp
 		return nil, fmt.Errorf("CancelKmsKeyDeletion: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CancelKmsKeyDeletion: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "cancel-kms-key-deletion")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("CancelKmsKeyDeletion: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CancelKmsKeyDeletion: http response: %w", err)
-	}
-
 	bodyresp := new(SuccessResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CancelKmsKeyDeletion: prepare JSON response: %w", err)
+	if err := c.do(ctx, "cancel-kms-key-deletion", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CancelKmsKeyDeletion: %w", err)
 	}
 
 	return bodyresp, nil
@@ -14114,30 +10684,9 @@ func (c Client) Decrypt(ctx context.Context, id UUID, req DecryptRequest) (*Decr
 		return nil, fmt.Errorf("Decrypt: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("Decrypt: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "decrypt")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("Decrypt: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("Decrypt: http response: %w", err)
-	}
-
 	bodyresp := new(DecryptResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("Decrypt: prepare JSON response: %w", err)
+	if err := c.do(ctx, "decrypt", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("Decrypt: %w", err)
 	}
 
 	return bodyresp, nil
@@ -14158,30 +10707,9 @@ func (c Client) DisableKmsKey(ctx context.Context, id UUID) (*SuccessResponse, e
 		return nil, fmt.Errorf("DisableKmsKey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DisableKmsKey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "disable-kms-key")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DisableKmsKey: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DisableKmsKey: http response: %w", err)
-	}
-
 	bodyresp := new(SuccessResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DisableKmsKey: prepare JSON response: %w", err)
+	if err := c.do(ctx, "disable-kms-key", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DisableKmsKey: %w", err)
 	}
 
 	return bodyresp, nil
@@ -14202,30 +10730,9 @@ func (c Client) DisableKmsKeyRotation(ctx context.Context, id UUID) (*DisableKms
 		return nil, fmt.Errorf("DisableKmsKeyRotation: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DisableKmsKeyRotation: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "disable-kms-key-rotation")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DisableKmsKeyRotation: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DisableKmsKeyRotation: http response: %w", err)
-	}
-
 	bodyresp := new(DisableKmsKeyRotationResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DisableKmsKeyRotation: prepare JSON response: %w", err)
+	if err := c.do(ctx, "disable-kms-key-rotation", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DisableKmsKeyRotation: %w", err)
 	}
 
 	return bodyresp, nil
@@ -14246,30 +10753,9 @@ func (c Client) EnableKmsKey(ctx context.Context, id UUID) (*SuccessResponse, er
 		return nil, fmt.Errorf("EnableKmsKey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("EnableKmsKey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "enable-kms-key")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("EnableKmsKey: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("EnableKmsKey: http response: %w", err)
-	}
-
 	bodyresp := new(SuccessResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("EnableKmsKey: prepare JSON response: %w", err)
+	if err := c.do(ctx, "enable-kms-key", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("EnableKmsKey: %w", err)
 	}
 
 	return bodyresp, nil
@@ -14297,30 +10783,9 @@ func (c Client) EnableKmsKeyRotation(ctx context.Context, id UUID, req EnableKms
 		return nil, fmt.Errorf("EnableKmsKeyRotation: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("EnableKmsKeyRotation: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "enable-kms-key-rotation")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("EnableKmsKeyRotation: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("EnableKmsKeyRotation: http response: %w", err)
-	}
-
 	bodyresp := new(EnableKmsKeyRotationResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("EnableKmsKeyRotation: prepare JSON response: %w", err)
+	if err := c.do(ctx, "enable-kms-key-rotation", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("EnableKmsKeyRotation: %w", err)
 	}
 
 	return bodyresp, nil
@@ -14348,30 +10813,9 @@ func (c Client) Encrypt(ctx context.Context, id UUID, req EncryptRequest) (*Encr
 		return nil, fmt.Errorf("Encrypt: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("Encrypt: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "encrypt")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("Encrypt: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("Encrypt: http response: %w", err)
-	}
-
 	bodyresp := new(EncryptResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("Encrypt: prepare JSON response: %w", err)
+	if err := c.do(ctx, "encrypt", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("Encrypt: %w", err)
 	}
 
 	return bodyresp, nil
@@ -14399,30 +10843,9 @@ func (c Client) GenerateDataKey(ctx context.Context, id UUID, req GenerateDataKe
 		return nil, fmt.Errorf("GenerateDataKey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GenerateDataKey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "generate-data-key")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GenerateDataKey: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GenerateDataKey: http response: %w", err)
-	}
-
 	bodyresp := new(GenerateDataKeyResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GenerateDataKey: prepare JSON response: %w", err)
+	if err := c.do(ctx, "generate-data-key", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GenerateDataKey: %w", err)
 	}
 
 	return bodyresp, nil
@@ -14443,33 +10866,41 @@ func (c Client) ListKmsKeyRotations(ctx context.Context, id UUID) (*ListKmsKeyRo
 		return nil, fmt.Errorf("ListKmsKeyRotations: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListKmsKeyRotations: sign request: %w", err)
+	bodyresp := new(ListKmsKeyRotationsResponse)
+	if err := c.do(ctx, "list-kms-key-rotations", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ListKmsKeyRotations: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "list-kms-key-rotations")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ListKmsKeyRotations: http client do: %w", err)
-	}
+// ListKmsKeyRotationsSeq returns an iterator over the elements listed by ListKmsKeyRotations,
+// decoded while the response is read. The iteration stops at the first error,
+// yielded with a zero element, or once the context is done.
+func (c Client) ListKmsKeyRotationsSeq(ctx context.Context, id UUID) iter.Seq2[ListKmsKeyRotationsResponseEntry, error] {
+	return func(yield func(ListKmsKeyRotationsResponseEntry, error) bool) {
+		path := fmt.Sprintf("/kms-key/%v/list-key-rotations", id)
 
-	if c.trace {
-		dumpResponse(response)
-	}
+		request, err := http.NewRequestWithContext(ctx, "GET", c.serverEndpoint+path, nil)
+		if err != nil {
+			yieldError(yield, fmt.Errorf("ListKmsKeyRotationsSeq: new request: %w", err))
+			return
+		}
+
+		request.Header.Add("User-Agent", c.getUserAgent())
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ListKmsKeyRotations: http response: %w", err)
-	}
+		if err := c.executeRequestInterceptors(ctx, request); err != nil {
+			yieldError(yield, fmt.Errorf("ListKmsKeyRotationsSeq: execute request editors: %w", err))
+			return
+		}
 
-	bodyresp := new(ListKmsKeyRotationsResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ListKmsKeyRotations: prepare JSON response: %w", err)
+		bodyresp := new(ListKmsKeyRotationsResponse)
+		call := &Call{OperationID: "list-kms-key-rotations", Request: request, Result: bodyresp}
+		elems := func() []ListKmsKeyRotationsResponseEntry { return bodyresp.Rotations }
+		if err := streamList(ctx, c, call, true, "rotations", elems, yield); err != nil {
+			yieldError(yield, fmt.Errorf("ListKmsKeyRotationsSeq: %w", err))
+		}
 	}
-
-	return bodyresp, nil
 }
 
 // Decrypts an existing ciphertext using its original key material and re-encrypts the underlying plaintext using a specified KMS key or the latest key material of the same KMS Key.
@@ -14494,30 +10925,9 @@ func (c Client) ReEncrypt(ctx context.Context, id UUID, req ReEncryptRequest) (*
 		return nil, fmt.Errorf("ReEncrypt: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ReEncrypt: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "re-encrypt")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ReEncrypt: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ReEncrypt: http response: %w", err)
-	}
-
 	bodyresp := new(ReEncryptResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ReEncrypt: prepare JSON response: %w", err)
+	if err := c.do(ctx, "re-encrypt", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ReEncrypt: %w", err)
 	}
 
 	return bodyresp, nil
@@ -14545,30 +10955,9 @@ func (c Client) ReplicateKmsKey(ctx context.Context, id UUID, req ReplicateKmsKe
 		return nil, fmt.Errorf("ReplicateKmsKey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ReplicateKmsKey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "replicate-kms-key")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ReplicateKmsKey: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ReplicateKmsKey: http response: %w", err)
-	}
-
 	bodyresp := new(SuccessResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ReplicateKmsKey: prepare JSON response: %w", err)
+	if err := c.do(ctx, "replicate-kms-key", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ReplicateKmsKey: %w", err)
 	}
 
 	return bodyresp, nil
@@ -14589,30 +10978,9 @@ func (c Client) RotateKmsKey(ctx context.Context, id UUID) (*RotateKmsKeyRespons
 		return nil, fmt.Errorf("RotateKmsKey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RotateKmsKey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "rotate-kms-key")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("RotateKmsKey: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("RotateKmsKey: http response: %w", err)
-	}
-
 	bodyresp := new(RotateKmsKeyResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("RotateKmsKey: prepare JSON response: %w", err)
+	if err := c.do(ctx, "rotate-kms-key", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("RotateKmsKey: %w", err)
 	}
 
 	return bodyresp, nil
@@ -14640,30 +11008,9 @@ func (c Client) ScheduleKmsKeyDeletion(ctx context.Context, id UUID, req Schedul
 		return nil, fmt.Errorf("ScheduleKmsKeyDeletion: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ScheduleKmsKeyDeletion: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "schedule-kms-key-deletion")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ScheduleKmsKeyDeletion: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ScheduleKmsKeyDeletion: http response: %w", err)
-	}
-
 	bodyresp := new(ScheduleKmsKeyDeletionResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ScheduleKmsKeyDeletion: prepare JSON response: %w", err)
+	if err := c.do(ctx, "schedule-kms-key-deletion", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ScheduleKmsKeyDeletion: %w", err)
 	}
 
 	return bodyresp, nil
@@ -14684,30 +11031,9 @@ func (c Client) GetLiveBalance(ctx context.Context) (*LiveBalance, error) {
 		return nil, fmt.Errorf("GetLiveBalance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetLiveBalance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-live-balance")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetLiveBalance: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetLiveBalance: http response: %w", err)
-	}
-
 	bodyresp := new(LiveBalance)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetLiveBalance: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-live-balance", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetLiveBalance: %w", err)
 	}
 
 	return bodyresp, nil
@@ -14751,33 +11077,41 @@ func (c Client) ListLoadBalancers(ctx context.Context) (*ListLoadBalancersRespon
 		return nil, fmt.Errorf("ListLoadBalancers: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListLoadBalancers: sign request: %w", err)
+	bodyresp := new(ListLoadBalancersResponse)
+	if err := c.do(ctx, "list-load-balancers", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ListLoadBalancers: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "list-load-balancers")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ListLoadBalancers: http client do: %w", err)
-	}
+// ListLoadBalancersSeq returns an iterator over the elements listed by ListLoadBalancers,
+// decoded while the response is read. The iteration stops at the first error,
+// yielded with a zero element, or once the context is done.
+func (c Client) ListLoadBalancersSeq(ctx context.Context) iter.Seq2[LoadBalancer, error] {
+	return func(yield func(LoadBalancer, error) bool) {
+		path := "/load-balancer"
 
-	if c.trace {
-		dumpResponse(response)
-	}
+		request, err := http.NewRequestWithContext(ctx, "GET", c.serverEndpoint+path, nil)
+		if err != nil {
+			yieldError(yield, fmt.Errorf("ListLoadBalancersSeq: new request: %w", err))
+			return
+		}
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ListLoadBalancers: http response: %w", err)
-	}
+		request.Header.Add("User-Agent", c.getUserAgent())
 
-	bodyresp := new(ListLoadBalancersResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ListLoadBalancers: prepare JSON response: %w", err)
-	}
+		if err := c.executeRequestInterceptors(ctx, request); err != nil {
+			yieldError(yield, fmt.Errorf("ListLoadBalancersSeq: execute request editors: %w", err))
+			return
+		}
 
-	return bodyresp, nil
+		bodyresp := new(ListLoadBalancersResponse)
+		call := &Call{OperationID: "list-load-balancers", Request: request, Result: bodyresp}
+		elems := func() []LoadBalancer { return bodyresp.LoadBalancers }
+		if err := streamList(ctx, c, call, true, "load-balancers", elems, yield); err != nil {
+			yieldError(yield, fmt.Errorf("ListLoadBalancersSeq: %w", err))
+		}
+	}
 }
 
 type CreateLoadBalancerRequest struct {
@@ -14810,33 +11144,40 @@ func (c Client) CreateLoadBalancer(ctx context.Context, req CreateLoadBalancerRe
 		return nil, fmt.Errorf("CreateLoadBalancer: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateLoadBalancer: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "create-load-balancer", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateLoadBalancer: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "create-load-balancer")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// CreateLoadBalancerAndWait calls CreateLoadBalancer, waits for the operation to succeed
+// and returns the created resource.
+func (c Client) CreateLoadBalancerAndWait(ctx context.Context, req CreateLoadBalancerRequest) (*LoadBalancer, error) {
+	op, err := c.CreateLoadBalancer(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("CreateLoadBalancer: http client do: %w", err)
+		return nil, fmt.Errorf("CreateLoadBalancerAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateLoadBalancer: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("CreateLoadBalancerAndWait: %w", &OperationError{ID: op.ID, Err: err})
+	}
+	if done.Reference == nil {
+		return nil, fmt.Errorf("CreateLoadBalancerAndWait: %w", &OperationError{ID: op.ID, Err: ErrMissingOperationReference})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateLoadBalancer: prepare JSON response: %w", err)
+	resp, err := c.GetLoadBalancer(ctx, done.Reference.ID)
+	if err != nil {
+		return nil, fmt.Errorf("CreateLoadBalancerAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 // Delete a Load Balancer
@@ -14854,30 +11195,9 @@ func (c Client) DeleteLoadBalancer(ctx context.Context, id UUID) (*Operation, er
 		return nil, fmt.Errorf("DeleteLoadBalancer: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteLoadBalancer: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-load-balancer")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteLoadBalancer: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteLoadBalancer: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteLoadBalancer: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-load-balancer", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteLoadBalancer: %w", err)
 	}
 
 	return bodyresp, nil
@@ -14898,30 +11218,9 @@ func (c Client) GetLoadBalancer(ctx context.Context, id UUID) (*LoadBalancer, er
 		return nil, fmt.Errorf("GetLoadBalancer: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetLoadBalancer: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-load-balancer")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetLoadBalancer: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetLoadBalancer: http response: %w", err)
-	}
-
 	bodyresp := new(LoadBalancer)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetLoadBalancer: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-load-balancer", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetLoadBalancer: %w", err)
 	}
 
 	return bodyresp, nil
@@ -14957,33 +11256,37 @@ func (c Client) UpdateLoadBalancer(ctx context.Context, id UUID, req UpdateLoadB
 		return nil, fmt.Errorf("UpdateLoadBalancer: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateLoadBalancer: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "update-load-balancer", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateLoadBalancer: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "update-load-balancer")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// UpdateLoadBalancerAndWait calls UpdateLoadBalancer, waits for the operation to succeed
+// and returns the updated resource.
+func (c Client) UpdateLoadBalancerAndWait(ctx context.Context, id UUID, req UpdateLoadBalancerRequest) (*LoadBalancer, error) {
+	op, err := c.UpdateLoadBalancer(ctx, id, req)
 	if err != nil {
-		return nil, fmt.Errorf("UpdateLoadBalancer: http client do: %w", err)
+		return nil, fmt.Errorf("UpdateLoadBalancerAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("UpdateLoadBalancer: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateLoadBalancerAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("UpdateLoadBalancer: prepare JSON response: %w", err)
+	resp, err := c.GetLoadBalancer(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateLoadBalancerAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 type AddServiceToLoadBalancerRequestProtocol string
@@ -15042,30 +11345,9 @@ func (c Client) AddServiceToLoadBalancer(ctx context.Context, id UUID, req AddSe
 		return nil, fmt.Errorf("AddServiceToLoadBalancer: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("AddServiceToLoadBalancer: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "add-service-to-load-balancer")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("AddServiceToLoadBalancer: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("AddServiceToLoadBalancer: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("AddServiceToLoadBalancer: prepare JSON response: %w", err)
+	if err := c.do(ctx, "add-service-to-load-balancer", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("AddServiceToLoadBalancer: %w", err)
 	}
 
 	return bodyresp, nil
@@ -15086,30 +11368,9 @@ func (c Client) DeleteLoadBalancerService(ctx context.Context, id UUID, serviceI
 		return nil, fmt.Errorf("DeleteLoadBalancerService: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteLoadBalancerService: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-load-balancer-service")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteLoadBalancerService: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteLoadBalancerService: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteLoadBalancerService: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-load-balancer-service", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteLoadBalancerService: %w", err)
 	}
 
 	return bodyresp, nil
@@ -15130,30 +11391,9 @@ func (c Client) GetLoadBalancerService(ctx context.Context, id UUID, serviceID U
 		return nil, fmt.Errorf("GetLoadBalancerService: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetLoadBalancerService: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-load-balancer-service")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetLoadBalancerService: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetLoadBalancerService: http response: %w", err)
-	}
-
 	bodyresp := new(LoadBalancerService)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetLoadBalancerService: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-load-balancer-service", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetLoadBalancerService: %w", err)
 	}
 
 	return bodyresp, nil
@@ -15213,33 +11453,37 @@ func (c Client) UpdateLoadBalancerService(ctx context.Context, id UUID, serviceI
 		return nil, fmt.Errorf("UpdateLoadBalancerService: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateLoadBalancerService: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "update-load-balancer-service", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateLoadBalancerService: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "update-load-balancer-service")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// UpdateLoadBalancerServiceAndWait calls UpdateLoadBalancerService, waits for the operation to succeed
+// and returns the updated resource.
+func (c Client) UpdateLoadBalancerServiceAndWait(ctx context.Context, id UUID, serviceID UUID, req UpdateLoadBalancerServiceRequest) (*LoadBalancerService, error) {
+	op, err := c.UpdateLoadBalancerService(ctx, id, serviceID, req)
 	if err != nil {
-		return nil, fmt.Errorf("UpdateLoadBalancerService: http client do: %w", err)
+		return nil, fmt.Errorf("UpdateLoadBalancerServiceAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("UpdateLoadBalancerService: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateLoadBalancerServiceAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("UpdateLoadBalancerService: prepare JSON response: %w", err)
+	resp, err := c.GetLoadBalancerService(ctx, id, serviceID)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateLoadBalancerServiceAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 type ResetLoadBalancerServiceFieldField string
@@ -15263,30 +11507,9 @@ func (c Client) ResetLoadBalancerServiceField(ctx context.Context, id UUID, serv
 		return nil, fmt.Errorf("ResetLoadBalancerServiceField: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetLoadBalancerServiceField: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-load-balancer-service-field")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ResetLoadBalancerServiceField: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ResetLoadBalancerServiceField: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ResetLoadBalancerServiceField: prepare JSON response: %w", err)
+	if err := c.do(ctx, "reset-load-balancer-service-field", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ResetLoadBalancerServiceField: %w", err)
 	}
 
 	return bodyresp, nil
@@ -15314,30 +11537,9 @@ func (c Client) ResetLoadBalancerField(ctx context.Context, id UUID, field Reset
 		return nil, fmt.Errorf("ResetLoadBalancerField: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetLoadBalancerField: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-load-balancer-field")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ResetLoadBalancerField: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ResetLoadBalancerField: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ResetLoadBalancerField: prepare JSON response: %w", err)
+	if err := c.do(ctx, "reset-load-balancer-field", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ResetLoadBalancerField: %w", err)
 	}
 
 	return bodyresp, nil
@@ -15358,30 +11560,9 @@ func (c Client) GetOperation(ctx context.Context, id UUID) (*Operation, error) {
 		return nil, fmt.Errorf("GetOperation: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetOperation: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-operation")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetOperation: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetOperation: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetOperation: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-operation", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetOperation: %w", err)
 	}
 
 	return bodyresp, nil
@@ -15402,30 +11583,9 @@ func (c Client) GetOrganization(ctx context.Context) (*Organization, error) {
 		return nil, fmt.Errorf("GetOrganization: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetOrganization: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-organization")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetOrganization: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetOrganization: http response: %w", err)
-	}
-
 	bodyresp := new(Organization)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetOrganization: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-organization", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetOrganization: %w", err)
 	}
 
 	return bodyresp, nil
@@ -15469,33 +11629,41 @@ func (c Client) ListPrivateNetworks(ctx context.Context) (*ListPrivateNetworksRe
 		return nil, fmt.Errorf("ListPrivateNetworks: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListPrivateNetworks: sign request: %w", err)
+	bodyresp := new(ListPrivateNetworksResponse)
+	if err := c.do(ctx, "list-private-networks", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ListPrivateNetworks: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "list-private-networks")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ListPrivateNetworks: http client do: %w", err)
-	}
+// ListPrivateNetworksSeq returns an iterator over the elements listed by ListPrivateNetworks,
+// decoded while the response is read. The iteration stops at the first error,
+// yielded with a zero element, or once the context is done.
+func (c Client) ListPrivateNetworksSeq(ctx context.Context) iter.Seq2[PrivateNetwork, error] {
+	return func(yield func(PrivateNetwork, error) bool) {
+		path := "/private-network"
 
-	if c.trace {
-		dumpResponse(response)
-	}
+		request, err := http.NewRequestWithContext(ctx, "GET", c.serverEndpoint+path, nil)
+		if err != nil {
+			yieldError(yield, fmt.Errorf("ListPrivateNetworksSeq: new request: %w", err))
+			return
+		}
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ListPrivateNetworks: http response: %w", err)
-	}
+		request.Header.Add("User-Agent", c.getUserAgent())
 
-	bodyresp := new(ListPrivateNetworksResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ListPrivateNetworks: prepare JSON response: %w", err)
-	}
+		if err := c.executeRequestInterceptors(ctx, request); err != nil {
+			yieldError(yield, fmt.Errorf("ListPrivateNetworksSeq: execute request editors: %w", err))
+			return
+		}
 
-	return bodyresp, nil
+		bodyresp := new(ListPrivateNetworksResponse)
+		call := &Call{OperationID: "list-private-networks", Request: request, Result: bodyresp}
+		elems := func() []PrivateNetwork { return bodyresp.PrivateNetworks }
+		if err := streamList(ctx, c, call, true, "private-networks", elems, yield); err != nil {
+			yieldError(yield, fmt.Errorf("ListPrivateNetworksSeq: %w", err))
+		}
+	}
 }
 
 type CreatePrivateNetworkRequest struct {
@@ -15536,33 +11704,40 @@ func (c Client) CreatePrivateNetwork(ctx context.Context, req CreatePrivateNetwo
 		return nil, fmt.Errorf("CreatePrivateNetwork: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreatePrivateNetwork: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "create-private-network", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreatePrivateNetwork: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "create-private-network")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// CreatePrivateNetworkAndWait calls CreatePrivateNetwork, waits for the operation to succeed
+// and returns the created resource.
+func (c Client) CreatePrivateNetworkAndWait(ctx context.Context, req CreatePrivateNetworkRequest) (*PrivateNetwork, error) {
+	op, err := c.CreatePrivateNetwork(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("CreatePrivateNetwork: http client do: %w", err)
+		return nil, fmt.Errorf("CreatePrivateNetworkAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreatePrivateNetwork: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("CreatePrivateNetworkAndWait: %w", &OperationError{ID: op.ID, Err: err})
+	}
+	if done.Reference == nil {
+		return nil, fmt.Errorf("CreatePrivateNetworkAndWait: %w", &OperationError{ID: op.ID, Err: ErrMissingOperationReference})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreatePrivateNetwork: prepare JSON response: %w", err)
+	resp, err := c.GetPrivateNetwork(ctx, done.Reference.ID)
+	if err != nil {
+		return nil, fmt.Errorf("CreatePrivateNetworkAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 // Delete a Private Network
@@ -15580,30 +11755,9 @@ func (c Client) DeletePrivateNetwork(ctx context.Context, id UUID) (*Operation,
 		return nil, fmt.Errorf("DeletePrivateNetwork: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeletePrivateNetwork: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-private-network")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeletePrivateNetwork: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeletePrivateNetwork: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeletePrivateNetwork: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-private-network", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeletePrivateNetwork: %w", err)
 	}
 
 	return bodyresp, nil
@@ -15624,30 +11778,9 @@ func (c Client) GetPrivateNetwork(ctx context.Context, id UUID) (*PrivateNetwork
 		return nil, fmt.Errorf("GetPrivateNetwork: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetPrivateNetwork: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-private-network")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetPrivateNetwork: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetPrivateNetwork: http response: %w", err)
-	}
-
 	bodyresp := new(PrivateNetwork)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetPrivateNetwork: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-private-network", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetPrivateNetwork: %w", err)
 	}
 
 	return bodyresp, nil
@@ -15691,33 +11824,37 @@ func (c Client) UpdatePrivateNetwork(ctx context.Context, id UUID, req UpdatePri
 		return nil, fmt.Errorf("UpdatePrivateNetwork: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdatePrivateNetwork: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "update-private-network", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdatePrivateNetwork: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "update-private-network")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// UpdatePrivateNetworkAndWait calls UpdatePrivateNetwork, waits for the operation to succeed
+// and returns the updated resource.
+func (c Client) UpdatePrivateNetworkAndWait(ctx context.Context, id UUID, req UpdatePrivateNetworkRequest) (*PrivateNetwork, error) {
+	op, err := c.UpdatePrivateNetwork(ctx, id, req)
 	if err != nil {
-		return nil, fmt.Errorf("UpdatePrivateNetwork: http client do: %w", err)
+		return nil, fmt.Errorf("UpdatePrivateNetworkAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("UpdatePrivateNetwork: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("UpdatePrivateNetworkAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("UpdatePrivateNetwork: prepare JSON response: %w", err)
+	resp, err := c.GetPrivateNetwork(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("UpdatePrivateNetworkAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 type ResetPrivateNetworkFieldField string
@@ -15741,30 +11878,9 @@ func (c Client) ResetPrivateNetworkField(ctx context.Context, id UUID, field Res
 		return nil, fmt.Errorf("ResetPrivateNetworkField: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetPrivateNetworkField: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-private-network-field")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ResetPrivateNetworkField: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ResetPrivateNetworkField: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ResetPrivateNetworkField: prepare JSON response: %w", err)
+	if err := c.do(ctx, "reset-private-network-field", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ResetPrivateNetworkField: %w", err)
 	}
 
 	return bodyresp, nil
@@ -15805,30 +11921,9 @@ func (c Client) AttachInstanceToPrivateNetwork(ctx context.Context, id UUID, req
 		return nil, fmt.Errorf("AttachInstanceToPrivateNetwork: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("AttachInstanceToPrivateNetwork: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "attach-instance-to-private-network")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("AttachInstanceToPrivateNetwork: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("AttachInstanceToPrivateNetwork: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("AttachInstanceToPrivateNetwork: prepare JSON response: %w", err)
+	if err := c.do(ctx, "attach-instance-to-private-network", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("AttachInstanceToPrivateNetwork: %w", err)
 	}
 
 	return bodyresp, nil
@@ -15861,30 +11956,9 @@ func (c Client) DetachInstanceFromPrivateNetwork(ctx context.Context, id UUID, r
 		return nil, fmt.Errorf("DetachInstanceFromPrivateNetwork: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DetachInstanceFromPrivateNetwork: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "detach-instance-from-private-network")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DetachInstanceFromPrivateNetwork: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DetachInstanceFromPrivateNetwork: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DetachInstanceFromPrivateNetwork: prepare JSON response: %w", err)
+	if err := c.do(ctx, "detach-instance-from-private-network", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DetachInstanceFromPrivateNetwork: %w", err)
 	}
 
 	return bodyresp, nil
@@ -15923,30 +11997,9 @@ func (c Client) UpdatePrivateNetworkInstanceIP(ctx context.Context, id UUID, req
 		return nil, fmt.Errorf("UpdatePrivateNetworkInstanceIP: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdatePrivateNetworkInstanceIP: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-private-network-instance-ip")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("UpdatePrivateNetworkInstanceIP: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("UpdatePrivateNetworkInstanceIP: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("UpdatePrivateNetworkInstanceIP: prepare JSON response: %w", err)
+	if err := c.do(ctx, "update-private-network-instance-ip", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdatePrivateNetworkInstanceIP: %w", err)
 	}
 
 	return bodyresp, nil
@@ -15971,33 +12024,41 @@ func (c Client) ListQuotas(ctx context.Context) (*ListQuotasResponse, error) {
 		return nil, fmt.Errorf("ListQuotas: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListQuotas: sign request: %w", err)
+	bodyresp := new(ListQuotasResponse)
+	if err := c.do(ctx, "list-quotas", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ListQuotas: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "list-quotas")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ListQuotas: http client do: %w", err)
-	}
+// ListQuotasSeq returns an iterator over the elements listed by ListQuotas,
+// decoded while the response is read. The iteration stops at the first error,
+// yielded with a zero element, or once the context is done.
+func (c Client) ListQuotasSeq(ctx context.Context) iter.Seq2[Quota, error] {
+	return func(yield func(Quota, error) bool) {
+		path := "/quota"
 
-	if c.trace {
-		dumpResponse(response)
-	}
+		request, err := http.NewRequestWithContext(ctx, "GET", c.serverEndpoint+path, nil)
+		if err != nil {
+			yieldError(yield, fmt.Errorf("ListQuotasSeq: new request: %w", err))
+			return
+		}
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ListQuotas: http response: %w", err)
-	}
+		request.Header.Add("User-Agent", c.getUserAgent())
 
-	bodyresp := new(ListQuotasResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ListQuotas: prepare JSON response: %w", err)
-	}
+		if err := c.executeRequestInterceptors(ctx, request); err != nil {
+			yieldError(yield, fmt.Errorf("ListQuotasSeq: execute request editors: %w", err))
+			return
+		}
 
-	return bodyresp, nil
+		bodyresp := new(ListQuotasResponse)
+		call := &Call{OperationID: "list-quotas", Request: request, Result: bodyresp}
+		elems := func() []Quota { return bodyresp.Quotas }
+		if err := streamList(ctx, c, call, true, "quotas", elems, yield); err != nil {
+			yieldError(yield, fmt.Errorf("ListQuotasSeq: %w", err))
+		}
+	}
 }
 
 // Retrieve Resource Quota
@@ -16015,30 +12076,9 @@ func (c Client) GetQuota(ctx context.Context, entity string) (*Quota, error) {
 		return nil, fmt.Errorf("GetQuota: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetQuota: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-quota")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetQuota: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetQuota: http response: %w", err)
-	}
-
 	bodyresp := new(Quota)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetQuota: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-quota", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetQuota: %w", err)
 	}
 
 	return bodyresp, nil
@@ -16059,30 +12099,9 @@ func (c Client) DeleteReverseDNSElasticIP(ctx context.Context, id UUID) (*Operat
 		return nil, fmt.Errorf("DeleteReverseDNSElasticIP: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteReverseDNSElasticIP: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-reverse-dns-elastic-ip")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteReverseDNSElasticIP: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteReverseDNSElasticIP: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteReverseDNSElasticIP: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-reverse-dns-elastic-ip", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteReverseDNSElasticIP: %w", err)
 	}
 
 	return bodyresp, nil
@@ -16103,30 +12122,9 @@ func (c Client) GetReverseDNSElasticIP(ctx context.Context, id UUID) (*ReverseDN
 		return nil, fmt.Errorf("GetReverseDNSElasticIP: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetReverseDNSElasticIP: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-reverse-dns-elastic-ip")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetReverseDNSElasticIP: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetReverseDNSElasticIP: http response: %w", err)
-	}
-
 	bodyresp := new(ReverseDNSRecord)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetReverseDNSElasticIP: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-reverse-dns-elastic-ip", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetReverseDNSElasticIP: %w", err)
 	}
 
 	return bodyresp, nil
@@ -16158,30 +12156,9 @@ func (c Client) UpdateReverseDNSElasticIP(ctx context.Context, id UUID, req Upda
 		return nil, fmt.Errorf("UpdateReverseDNSElasticIP: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateReverseDNSElasticIP: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-reverse-dns-elastic-ip")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("UpdateReverseDNSElasticIP: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("UpdateReverseDNSElasticIP: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("UpdateReverseDNSElasticIP: prepare JSON response: %w", err)
+	if err := c.do(ctx, "update-reverse-dns-elastic-ip", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateReverseDNSElasticIP: %w", err)
 	}
 
 	return bodyresp, nil
@@ -16202,30 +12179,9 @@ func (c Client) DeleteReverseDNSInstance(ctx context.Context, id UUID) (*Operati
 		return nil, fmt.Errorf("DeleteReverseDNSInstance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteReverseDNSInstance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-reverse-dns-instance")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteReverseDNSInstance: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteReverseDNSInstance: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteReverseDNSInstance: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-reverse-dns-instance", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteReverseDNSInstance: %w", err)
 	}
 
 	return bodyresp, nil
@@ -16246,30 +12202,9 @@ func (c Client) GetReverseDNSInstance(ctx context.Context, id UUID) (*ReverseDNS
 		return nil, fmt.Errorf("GetReverseDNSInstance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetReverseDNSInstance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-reverse-dns-instance")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetReverseDNSInstance: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetReverseDNSInstance: http response: %w", err)
-	}
-
 	bodyresp := new(ReverseDNSRecord)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetReverseDNSInstance: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-reverse-dns-instance", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetReverseDNSInstance: %w", err)
 	}
 
 	return bodyresp, nil
@@ -16301,30 +12236,9 @@ func (c Client) UpdateReverseDNSInstance(ctx context.Context, id UUID, req Updat
 		return nil, fmt.Errorf("UpdateReverseDNSInstance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateReverseDNSInstance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-reverse-dns-instance")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("UpdateReverseDNSInstance: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("UpdateReverseDNSInstance: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("UpdateReverseDNSInstance: prepare JSON response: %w", err)
+	if err := c.do(ctx, "update-reverse-dns-instance", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateReverseDNSInstance: %w", err)
 	}
 
 	return bodyresp, nil
@@ -16394,33 +12308,49 @@ func (c Client) ListSecurityGroups(ctx context.Context, opts ...ListSecurityGrou
 		return nil, fmt.Errorf("ListSecurityGroups: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListSecurityGroups: sign request: %w", err)
+	bodyresp := new(ListSecurityGroupsResponse)
+	if err := c.do(ctx, "list-security-groups", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ListSecurityGroups: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "list-security-groups")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ListSecurityGroups: http client do: %w", err)
-	}
+// ListSecurityGroupsSeq returns an iterator over the elements listed by ListSecurityGroups,
+// decoded while the response is read. The iteration stops at the first error,
+// yielded with a zero element, or once the context is done.
+func (c Client) ListSecurityGroupsSeq(ctx context.Context, opts ...ListSecurityGroupsOpt) iter.Seq2[SecurityGroup, error] {
+	return func(yield func(SecurityGroup, error) bool) {
+		path := "/security-group"
 
-	if c.trace {
-		dumpResponse(response)
-	}
+		request, err := http.NewRequestWithContext(ctx, "GET", c.serverEndpoint+path, nil)
+		if err != nil {
+			yieldError(yield, fmt.Errorf("ListSecurityGroupsSeq: new request: %w", err))
+			return
+		}
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ListSecurityGroups: http response: %w", err)
-	}
+		request.Header.Add("User-Agent", c.getUserAgent())
 
-	bodyresp := new(ListSecurityGroupsResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ListSecurityGroups: prepare JSON response: %w", err)
-	}
+		if len(opts) > 0 {
+			q := request.URL.Query()
+			for _, opt := range opts {
+				opt(q)
+			}
+			request.URL.RawQuery = q.Encode()
+		}
 
-	return bodyresp, nil
+		if err := c.executeRequestInterceptors(ctx, request); err != nil {
+			yieldError(yield, fmt.Errorf("ListSecurityGroupsSeq: execute request editors: %w", err))
+			return
+		}
+
+		bodyresp := new(ListSecurityGroupsResponse)
+		call := &Call{OperationID: "list-security-groups", Request: request, Result: bodyresp}
+		elems := func() []SecurityGroup { return bodyresp.SecurityGroups }
+		if err := streamList(ctx, c, call, true, "security-groups", elems, yield); err != nil {
+			yieldError(yield, fmt.Errorf("ListSecurityGroupsSeq: %w", err))
+		}
+	}
 }
 
 type CreateSecurityGroupRequest struct {
@@ -16452,33 +12382,40 @@ func (c Client) CreateSecurityGroup(ctx context.Context, req CreateSecurityGroup
 		return nil, fmt.Errorf("CreateSecurityGroup: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateSecurityGroup: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "create-security-group", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateSecurityGroup: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "create-security-group")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// CreateSecurityGroupAndWait calls CreateSecurityGroup, waits for the operation to succeed
+// and returns the created resource.
+func (c Client) CreateSecurityGroupAndWait(ctx context.Context, req CreateSecurityGroupRequest) (*SecurityGroup, error) {
+	op, err := c.CreateSecurityGroup(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("CreateSecurityGroup: http client do: %w", err)
+		return nil, fmt.Errorf("CreateSecurityGroupAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateSecurityGroup: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("CreateSecurityGroupAndWait: %w", &OperationError{ID: op.ID, Err: err})
+	}
+	if done.Reference == nil {
+		return nil, fmt.Errorf("CreateSecurityGroupAndWait: %w", &OperationError{ID: op.ID, Err: ErrMissingOperationReference})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateSecurityGroup: prepare JSON response: %w", err)
+	resp, err := c.GetSecurityGroup(ctx, done.Reference.ID)
+	if err != nil {
+		return nil, fmt.Errorf("CreateSecurityGroupAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 // Delete a Security Group
@@ -16496,30 +12433,9 @@ func (c Client) DeleteSecurityGroup(ctx context.Context, id UUID) (*Operation, e
 		return nil, fmt.Errorf("DeleteSecurityGroup: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteSecurityGroup: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-security-group")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteSecurityGroup: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteSecurityGroup: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteSecurityGroup: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-security-group", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteSecurityGroup: %w", err)
 	}
 
 	return bodyresp, nil
@@ -16540,30 +12456,9 @@ func (c Client) GetSecurityGroup(ctx context.Context, id UUID) (*SecurityGroup,
 		return nil, fmt.Errorf("GetSecurityGroup: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetSecurityGroup: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-security-group")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetSecurityGroup: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetSecurityGroup: http response: %w", err)
-	}
-
 	bodyresp := new(SecurityGroup)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetSecurityGroup: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-security-group", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetSecurityGroup: %w", err)
 	}
 
 	return bodyresp, nil
@@ -16636,30 +12531,9 @@ func (c Client) AddRuleToSecurityGroup(ctx context.Context, id UUID, req AddRule
 		return nil, fmt.Errorf("AddRuleToSecurityGroup: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("AddRuleToSecurityGroup: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "add-rule-to-security-group")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("AddRuleToSecurityGroup: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("AddRuleToSecurityGroup: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("AddRuleToSecurityGroup: prepare JSON response: %w", err)
+	if err := c.do(ctx, "add-rule-to-security-group", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("AddRuleToSecurityGroup: %w", err)
 	}
 
 	return bodyresp, nil
@@ -16680,30 +12554,9 @@ func (c Client) DeleteRuleFromSecurityGroup(ctx context.Context, id UUID, ruleID
 		return nil, fmt.Errorf("DeleteRuleFromSecurityGroup: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteRuleFromSecurityGroup: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-rule-from-security-group")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteRuleFromSecurityGroup: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteRuleFromSecurityGroup: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteRuleFromSecurityGroup: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-rule-from-security-group", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteRuleFromSecurityGroup: %w", err)
 	}
 
 	return bodyresp, nil
@@ -16736,30 +12589,9 @@ func (c Client) AddExternalSourceToSecurityGroup(ctx context.Context, id UUID, r
 		return nil, fmt.Errorf("AddExternalSourceToSecurityGroup: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("AddExternalSourceToSecurityGroup: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "add-external-source-to-security-group")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("AddExternalSourceToSecurityGroup: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("AddExternalSourceToSecurityGroup: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("AddExternalSourceToSecurityGroup: prepare JSON response: %w", err)
+	if err := c.do(ctx, "add-external-source-to-security-group", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("AddExternalSourceToSecurityGroup: %w", err)
 	}
 
 	return bodyresp, nil
@@ -16792,30 +12624,9 @@ func (c Client) AttachInstanceToSecurityGroup(ctx context.Context, id UUID, req
 		return nil, fmt.Errorf("AttachInstanceToSecurityGroup: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("AttachInstanceToSecurityGroup: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "attach-instance-to-security-group")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("AttachInstanceToSecurityGroup: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("AttachInstanceToSecurityGroup: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("AttachInstanceToSecurityGroup: prepare JSON response: %w", err)
+	if err := c.do(ctx, "attach-instance-to-security-group", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("AttachInstanceToSecurityGroup: %w", err)
 	}
 
 	return bodyresp, nil
@@ -16848,30 +12659,9 @@ func (c Client) DetachInstanceFromSecurityGroup(ctx context.Context, id UUID, re
 		return nil, fmt.Errorf("DetachInstanceFromSecurityGroup: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DetachInstanceFromSecurityGroup: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "detach-instance-from-security-group")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DetachInstanceFromSecurityGroup: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DetachInstanceFromSecurityGroup: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DetachInstanceFromSecurityGroup: prepare JSON response: %w", err)
+	if err := c.do(ctx, "detach-instance-from-security-group", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DetachInstanceFromSecurityGroup: %w", err)
 	}
 
 	return bodyresp, nil
@@ -16900,34 +12690,13 @@ func (c Client) RemoveExternalSourceFromSecurityGroup(ctx context.Context, id UU
 
 	request.Header.Add("Content-Type", "application/json")
 
-	if err := c.executeRequestInterceptors(ctx, request); err != nil {
-		return nil, fmt.Errorf("RemoveExternalSourceFromSecurityGroup: execute request editors: %w", err)
-	}
-
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RemoveExternalSourceFromSecurityGroup: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "remove-external-source-from-security-group")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("RemoveExternalSourceFromSecurityGroup: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("RemoveExternalSourceFromSecurityGroup: http response: %w", err)
+	if err := c.executeRequestInterceptors(ctx, request); err != nil {
+		return nil, fmt.Errorf("RemoveExternalSourceFromSecurityGroup: execute request editors: %w", err)
 	}
 
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("RemoveExternalSourceFromSecurityGroup: prepare JSON response: %w", err)
+	if err := c.do(ctx, "remove-external-source-from-security-group", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("RemoveExternalSourceFromSecurityGroup: %w", err)
 	}
 
 	return bodyresp, nil
@@ -16971,33 +12740,41 @@ func (c Client) ListSKSClusters(ctx context.Context) (*ListSKSClustersResponse,
 		return nil, fmt.Errorf("ListSKSClusters: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListSKSClusters: sign request: %w", err)
+	bodyresp := new(ListSKSClustersResponse)
+	if err := c.do(ctx, "list-sks-clusters", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ListSKSClusters: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "list-sks-clusters")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ListSKSClusters: http client do: %w", err)
-	}
+// ListSKSClustersSeq returns an iterator over the elements listed by ListSKSClusters,
+// decoded while the response is read. The iteration stops at the first error,
+// yielded with a zero element, or once the context is done.
+func (c Client) ListSKSClustersSeq(ctx context.Context) iter.Seq2[SKSCluster, error] {
+	return func(yield func(SKSCluster, error) bool) {
+		path := "/sks-cluster"
 
-	if c.trace {
-		dumpResponse(response)
-	}
+		request, err := http.NewRequestWithContext(ctx, "GET", c.serverEndpoint+path, nil)
+		if err != nil {
+			yieldError(yield, fmt.Errorf("ListSKSClustersSeq: new request: %w", err))
+			return
+		}
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ListSKSClusters: http response: %w", err)
-	}
+		request.Header.Add("User-Agent", c.getUserAgent())
 
-	bodyresp := new(ListSKSClustersResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ListSKSClusters: prepare JSON response: %w", err)
-	}
+		if err := c.executeRequestInterceptors(ctx, request); err != nil {
+			yieldError(yield, fmt.Errorf("ListSKSClustersSeq: execute request editors: %w", err))
+			return
+		}
 
-	return bodyresp, nil
+		bodyresp := new(ListSKSClustersResponse)
+		call := &Call{OperationID: "list-sks-clusters", Request: request, Result: bodyresp}
+		elems := func() []SKSCluster { return bodyresp.SKSClusters }
+		if err := streamList(ctx, c, call, true, "sks-clusters", elems, yield); err != nil {
+			yieldError(yield, fmt.Errorf("ListSKSClustersSeq: %w", err))
+		}
+	}
 }
 
 type CreateSKSClusterRequestCni string
@@ -17066,33 +12843,40 @@ func (c Client) CreateSKSCluster(ctx context.Context, req CreateSKSClusterReques
 		return nil, fmt.Errorf("CreateSKSCluster: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateSKSCluster: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "create-sks-cluster", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateSKSCluster: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "create-sks-cluster")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// CreateSKSClusterAndWait calls CreateSKSCluster, waits for the operation to succeed
+// and returns the created resource.
+func (c Client) CreateSKSClusterAndWait(ctx context.Context, req CreateSKSClusterRequest) (*SKSCluster, error) {
+	op, err := c.CreateSKSCluster(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("CreateSKSCluster: http client do: %w", err)
+		return nil, fmt.Errorf("CreateSKSClusterAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateSKSCluster: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("CreateSKSClusterAndWait: %w", &OperationError{ID: op.ID, Err: err})
+	}
+	if done.Reference == nil {
+		return nil, fmt.Errorf("CreateSKSClusterAndWait: %w", &OperationError{ID: op.ID, Err: ErrMissingOperationReference})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateSKSCluster: prepare JSON response: %w", err)
+	resp, err := c.GetSKSCluster(ctx, done.Reference.ID)
+	if err != nil {
+		return nil, fmt.Errorf("CreateSKSClusterAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 // This operation returns the deprecated resources for a given cluster
@@ -17110,33 +12894,41 @@ func (c Client) ListSKSClusterDeprecatedResources(ctx context.Context, id UUID)
 		return nil, fmt.Errorf("ListSKSClusterDeprecatedResources: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListSKSClusterDeprecatedResources: sign request: %w", err)
+	bodyresp := []SKSClusterDeprecatedResource{}
+	if err := c.do(ctx, "list-sks-cluster-deprecated-resources", request, true, &bodyresp); err != nil {
+		return nil, fmt.Errorf("ListSKSClusterDeprecatedResources: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "list-sks-cluster-deprecated-resources")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ListSKSClusterDeprecatedResources: http client do: %w", err)
-	}
+// ListSKSClusterDeprecatedResourcesSeq returns an iterator over the elements listed by ListSKSClusterDeprecatedResources,
+// decoded while the response is read. The iteration stops at the first error,
+// yielded with a zero element, or once the context is done.
+func (c Client) ListSKSClusterDeprecatedResourcesSeq(ctx context.Context, id UUID) iter.Seq2[SKSClusterDeprecatedResource, error] {
+	return func(yield func(SKSClusterDeprecatedResource, error) bool) {
+		path := fmt.Sprintf("/sks-cluster-deprecated-resources/%v", id)
 
-	if c.trace {
-		dumpResponse(response)
-	}
+		request, err := http.NewRequestWithContext(ctx, "GET", c.serverEndpoint+path, nil)
+		if err != nil {
+			yieldError(yield, fmt.Errorf("ListSKSClusterDeprecatedResourcesSeq: new request: %w", err))
+			return
+		}
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ListSKSClusterDeprecatedResources: http response: %w", err)
-	}
+		request.Header.Add("User-Agent", c.getUserAgent())
 
-	bodyresp := []SKSClusterDeprecatedResource{}
-	if err := prepareJSONResponse(response, &bodyresp); err != nil {
-		return nil, fmt.Errorf("ListSKSClusterDeprecatedResources: prepare JSON response: %w", err)
-	}
+		if err := c.executeRequestInterceptors(ctx, request); err != nil {
+			yieldError(yield, fmt.Errorf("ListSKSClusterDeprecatedResourcesSeq: execute request editors: %w", err))
+			return
+		}
 
-	return bodyresp, nil
+		bodyresp := []SKSClusterDeprecatedResource{}
+		call := &Call{OperationID: "list-sks-cluster-deprecated-resources", Request: request, Result: &bodyresp}
+		elems := func() []SKSClusterDeprecatedResource { return bodyresp }
+		if err := streamList(ctx, c, call, true, "", elems, yield); err != nil {
+			yieldError(yield, fmt.Errorf("ListSKSClusterDeprecatedResourcesSeq: %w", err))
+		}
+	}
 }
 
 type GenerateSKSClusterKubeconfigResponse struct {
@@ -17165,30 +12957,9 @@ func (c Client) GenerateSKSClusterKubeconfig(ctx context.Context, id UUID, req S
 		return nil, fmt.Errorf("GenerateSKSClusterKubeconfig: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GenerateSKSClusterKubeconfig: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "generate-sks-cluster-kubeconfig")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GenerateSKSClusterKubeconfig: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GenerateSKSClusterKubeconfig: http response: %w", err)
-	}
-
 	bodyresp := new(GenerateSKSClusterKubeconfigResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GenerateSKSClusterKubeconfig: prepare JSON response: %w", err)
+	if err := c.do(ctx, "generate-sks-cluster-kubeconfig", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GenerateSKSClusterKubeconfig: %w", err)
 	}
 
 	return bodyresp, nil
@@ -17229,33 +13000,49 @@ func (c Client) ListSKSClusterVersions(ctx context.Context, opts ...ListSKSClust
 		return nil, fmt.Errorf("ListSKSClusterVersions: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListSKSClusterVersions: sign request: %w", err)
+	bodyresp := new(ListSKSClusterVersionsResponse)
+	if err := c.do(ctx, "list-sks-cluster-versions", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ListSKSClusterVersions: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "list-sks-cluster-versions")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ListSKSClusterVersions: http client do: %w", err)
-	}
+// ListSKSClusterVersionsSeq returns an iterator over the elements listed by ListSKSClusterVersions,
+// decoded while the response is read. The iteration stops at the first error,
+// yielded with a zero element, or once the context is done.
+func (c Client) ListSKSClusterVersionsSeq(ctx context.Context, opts ...ListSKSClusterVersionsOpt) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		path := "/sks-cluster-version"
 
-	if c.trace {
-		dumpResponse(response)
-	}
+		request, err := http.NewRequestWithContext(ctx, "GET", c.serverEndpoint+path, nil)
+		if err != nil {
+			yieldError(yield, fmt.Errorf("ListSKSClusterVersionsSeq: new request: %w", err))
+			return
+		}
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ListSKSClusterVersions: http response: %w", err)
-	}
+		request.Header.Add("User-Agent", c.getUserAgent())
 
-	bodyresp := new(ListSKSClusterVersionsResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ListSKSClusterVersions: prepare JSON response: %w", err)
-	}
+		if len(opts) > 0 {
+			q := request.URL.Query()
+			for _, opt := range opts {
+				opt(q)
+			}
+			request.URL.RawQuery = q.Encode()
+		}
 
-	return bodyresp, nil
+		if err := c.executeRequestInterceptors(ctx, request); err != nil {
+			yieldError(yield, fmt.Errorf("ListSKSClusterVersionsSeq: execute request editors: %w", err))
+			return
+		}
+
+		bodyresp := new(ListSKSClusterVersionsResponse)
+		call := &Call{OperationID: "list-sks-cluster-versions", Request: request, Result: bodyresp}
+		elems := func() []string { return bodyresp.SKSClusterVersions }
+		if err := streamList(ctx, c, call, true, "sks-cluster-versions", elems, yield); err != nil {
+			yieldError(yield, fmt.Errorf("ListSKSClusterVersionsSeq: %w", err))
+		}
+	}
 }
 
 // Delete an SKS cluster
@@ -17273,30 +13060,9 @@ func (c Client) DeleteSKSCluster(ctx context.Context, id UUID) (*Operation, erro
 		return nil, fmt.Errorf("DeleteSKSCluster: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteSKSCluster: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-sks-cluster")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteSKSCluster: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteSKSCluster: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteSKSCluster: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-sks-cluster", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteSKSCluster: %w", err)
 	}
 
 	return bodyresp, nil
@@ -17317,30 +13083,9 @@ func (c Client) GetSKSCluster(ctx context.Context, id UUID) (*SKSCluster, error)
 		return nil, fmt.Errorf("GetSKSCluster: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetSKSCluster: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-sks-cluster")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetSKSCluster: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetSKSCluster: http response: %w", err)
-	}
-
 	bodyresp := new(SKSCluster)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetSKSCluster: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-sks-cluster", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetSKSCluster: %w", err)
 	}
 
 	return bodyresp, nil
@@ -17388,33 +13133,37 @@ func (c Client) UpdateSKSCluster(ctx context.Context, id UUID, req UpdateSKSClus
 		return nil, fmt.Errorf("UpdateSKSCluster: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateSKSCluster: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "update-sks-cluster", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateSKSCluster: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "update-sks-cluster")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// UpdateSKSClusterAndWait calls UpdateSKSCluster, waits for the operation to succeed
+// and returns the updated resource.
+func (c Client) UpdateSKSClusterAndWait(ctx context.Context, id UUID, req UpdateSKSClusterRequest) (*SKSCluster, error) {
+	op, err := c.UpdateSKSCluster(ctx, id, req)
 	if err != nil {
-		return nil, fmt.Errorf("UpdateSKSCluster: http client do: %w", err)
+		return nil, fmt.Errorf("UpdateSKSClusterAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("UpdateSKSCluster: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateSKSClusterAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("UpdateSKSCluster: prepare JSON response: %w", err)
+	resp, err := c.GetSKSCluster(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateSKSClusterAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 type GetSKSClusterAuthorityCertResponse struct {
@@ -17444,30 +13193,9 @@ func (c Client) GetSKSClusterAuthorityCert(ctx context.Context, id UUID, authori
 		return nil, fmt.Errorf("GetSKSClusterAuthorityCert: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetSKSClusterAuthorityCert: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-sks-cluster-authority-cert")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetSKSClusterAuthorityCert: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetSKSClusterAuthorityCert: http response: %w", err)
-	}
-
 	bodyresp := new(GetSKSClusterAuthorityCertResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetSKSClusterAuthorityCert: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-sks-cluster-authority-cert", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetSKSClusterAuthorityCert: %w", err)
 	}
 
 	return bodyresp, nil
@@ -17492,30 +13220,9 @@ func (c Client) GenerateSKSKarpenterExoscaleNodeclass(ctx context.Context, id UU
 		return nil, fmt.Errorf("GenerateSKSKarpenterExoscaleNodeclass: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GenerateSKSKarpenterExoscaleNodeclass: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "generate-sks-karpenter-exoscale-nodeclass")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GenerateSKSKarpenterExoscaleNodeclass: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GenerateSKSKarpenterExoscaleNodeclass: http response: %w", err)
-	}
-
 	bodyresp := new(GenerateSKSKarpenterExoscaleNodeclassResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GenerateSKSKarpenterExoscaleNodeclass: prepare JSON response: %w", err)
+	if err := c.do(ctx, "generate-sks-karpenter-exoscale-nodeclass", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GenerateSKSKarpenterExoscaleNodeclass: %w", err)
 	}
 
 	return bodyresp, nil
@@ -17540,30 +13247,9 @@ func (c Client) GenerateSKSKarpenterNodepool(ctx context.Context, id UUID) (*Gen
 		return nil, fmt.Errorf("GenerateSKSKarpenterNodepool: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GenerateSKSKarpenterNodepool: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "generate-sks-karpenter-nodepool")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GenerateSKSKarpenterNodepool: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GenerateSKSKarpenterNodepool: http response: %w", err)
-	}
-
 	bodyresp := new(GenerateSKSKarpenterNodepoolResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GenerateSKSKarpenterNodepool: prepare JSON response: %w", err)
+	if err := c.do(ctx, "generate-sks-karpenter-nodepool", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GenerateSKSKarpenterNodepool: %w", err)
 	}
 
 	return bodyresp, nil
@@ -17586,30 +13272,9 @@ func (c Client) GetSKSClusterInspection(ctx context.Context, id UUID) (*GetSKSCl
 		return nil, fmt.Errorf("GetSKSClusterInspection: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetSKSClusterInspection: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-sks-cluster-inspection")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetSKSClusterInspection: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetSKSClusterInspection: http response: %w", err)
-	}
-
 	bodyresp := new(GetSKSClusterInspectionResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetSKSClusterInspection: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-sks-cluster-inspection", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetSKSClusterInspection: %w", err)
 	}
 
 	return bodyresp, nil
@@ -17679,33 +13344,40 @@ func (c Client) CreateSKSNodepool(ctx context.Context, id UUID, req CreateSKSNod
 		return nil, fmt.Errorf("CreateSKSNodepool: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateSKSNodepool: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "create-sks-nodepool", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateSKSNodepool: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "create-sks-nodepool")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// CreateSKSNodepoolAndWait calls CreateSKSNodepool, waits for the operation to succeed
+// and returns the created resource.
+func (c Client) CreateSKSNodepoolAndWait(ctx context.Context, id UUID, req CreateSKSNodepoolRequest) (*SKSNodepool, error) {
+	op, err := c.CreateSKSNodepool(ctx, id, req)
 	if err != nil {
-		return nil, fmt.Errorf("CreateSKSNodepool: http client do: %w", err)
+		return nil, fmt.Errorf("CreateSKSNodepoolAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateSKSNodepool: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("CreateSKSNodepoolAndWait: %w", &OperationError{ID: op.ID, Err: err})
+	}
+	if done.Reference == nil {
+		return nil, fmt.Errorf("CreateSKSNodepoolAndWait: %w", &OperationError{ID: op.ID, Err: ErrMissingOperationReference})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateSKSNodepool: prepare JSON response: %w", err)
+	resp, err := c.GetSKSNodepool(ctx, id, done.Reference.ID)
+	if err != nil {
+		return nil, fmt.Errorf("CreateSKSNodepoolAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 // Delete an SKS Nodepool
@@ -17723,30 +13395,9 @@ func (c Client) DeleteSKSNodepool(ctx context.Context, id UUID, sksNodepoolID UU
 		return nil, fmt.Errorf("DeleteSKSNodepool: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteSKSNodepool: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-sks-nodepool")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteSKSNodepool: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteSKSNodepool: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteSKSNodepool: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-sks-nodepool", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteSKSNodepool: %w", err)
 	}
 
 	return bodyresp, nil
@@ -17767,30 +13418,9 @@ func (c Client) GetSKSNodepool(ctx context.Context, id UUID, sksNodepoolID UUID)
 		return nil, fmt.Errorf("GetSKSNodepool: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetSKSNodepool: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-sks-nodepool")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetSKSNodepool: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetSKSNodepool: http response: %w", err)
-	}
-
 	bodyresp := new(SKSNodepool)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetSKSNodepool: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-sks-nodepool", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetSKSNodepool: %w", err)
 	}
 
 	return bodyresp, nil
@@ -17856,33 +13486,37 @@ func (c Client) UpdateSKSNodepool(ctx context.Context, id UUID, sksNodepoolID UU
 		return nil, fmt.Errorf("UpdateSKSNodepool: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateSKSNodepool: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "update-sks-nodepool", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateSKSNodepool: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "update-sks-nodepool")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// UpdateSKSNodepoolAndWait calls UpdateSKSNodepool, waits for the operation to succeed
+// and returns the updated resource.
+func (c Client) UpdateSKSNodepoolAndWait(ctx context.Context, id UUID, sksNodepoolID UUID, req UpdateSKSNodepoolRequest) (*SKSNodepool, error) {
+	op, err := c.UpdateSKSNodepool(ctx, id, sksNodepoolID, req)
 	if err != nil {
-		return nil, fmt.Errorf("UpdateSKSNodepool: http client do: %w", err)
+		return nil, fmt.Errorf("UpdateSKSNodepoolAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("UpdateSKSNodepool: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateSKSNodepoolAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("UpdateSKSNodepool: prepare JSON response: %w", err)
+	resp, err := c.GetSKSNodepool(ctx, id, sksNodepoolID)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateSKSNodepoolAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 type EvictSKSNodepoolMembersRequest struct {
@@ -17911,30 +13545,9 @@ func (c Client) EvictSKSNodepoolMembers(ctx context.Context, id UUID, sksNodepoo
 		return nil, fmt.Errorf("EvictSKSNodepoolMembers: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("EvictSKSNodepoolMembers: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "evict-sks-nodepool-members")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("EvictSKSNodepoolMembers: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("EvictSKSNodepoolMembers: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("EvictSKSNodepoolMembers: prepare JSON response: %w", err)
+	if err := c.do(ctx, "evict-sks-nodepool-members", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("EvictSKSNodepoolMembers: %w", err)
 	}
 
 	return bodyresp, nil
@@ -17967,30 +13580,9 @@ func (c Client) ScaleSKSNodepool(ctx context.Context, id UUID, sksNodepoolID UUI
 		return nil, fmt.Errorf("ScaleSKSNodepool: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ScaleSKSNodepool: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "scale-sks-nodepool")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ScaleSKSNodepool: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ScaleSKSNodepool: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ScaleSKSNodepool: prepare JSON response: %w", err)
+	if err := c.do(ctx, "scale-sks-nodepool", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ScaleSKSNodepool: %w", err)
 	}
 
 	return bodyresp, nil
@@ -18011,30 +13603,9 @@ func (c Client) RotateSKSCcmCredentials(ctx context.Context, id UUID) (*Operatio
 		return nil, fmt.Errorf("RotateSKSCcmCredentials: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RotateSKSCcmCredentials: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "rotate-sks-ccm-credentials")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("RotateSKSCcmCredentials: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("RotateSKSCcmCredentials: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("RotateSKSCcmCredentials: prepare JSON response: %w", err)
+	if err := c.do(ctx, "rotate-sks-ccm-credentials", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("RotateSKSCcmCredentials: %w", err)
 	}
 
 	return bodyresp, nil
@@ -18055,30 +13626,9 @@ func (c Client) RotateSKSCsiCredentials(ctx context.Context, id UUID) (*Operatio
 		return nil, fmt.Errorf("RotateSKSCsiCredentials: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RotateSKSCsiCredentials: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "rotate-sks-csi-credentials")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("RotateSKSCsiCredentials: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("RotateSKSCsiCredentials: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("RotateSKSCsiCredentials: prepare JSON response: %w", err)
+	if err := c.do(ctx, "rotate-sks-csi-credentials", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("RotateSKSCsiCredentials: %w", err)
 	}
 
 	return bodyresp, nil
@@ -18099,30 +13649,9 @@ func (c Client) RotateSKSKarpenterCredentials(ctx context.Context, id UUID) (*Op
 		return nil, fmt.Errorf("RotateSKSKarpenterCredentials: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RotateSKSKarpenterCredentials: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "rotate-sks-karpenter-credentials")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("RotateSKSKarpenterCredentials: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("RotateSKSKarpenterCredentials: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("RotateSKSKarpenterCredentials: prepare JSON response: %w", err)
+	if err := c.do(ctx, "rotate-sks-karpenter-credentials", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("RotateSKSKarpenterCredentials: %w", err)
 	}
 
 	return bodyresp, nil
@@ -18132,41 +13661,20 @@ func (c Client) RotateSKSKarpenterCredentials(ctx context.Context, id UUID) (*Op
 func (c Client) RotateSKSOperatorsCA(ctx context.Context, id UUID) (*Operation, error) {
 	path := fmt.Sprintf("/sks-cluster/%v/rotate-operators-ca", id)
 
-	request, err := http.NewRequestWithContext(ctx, "PUT", c.serverEndpoint+path, nil)
-	if err != nil {
-		return nil, fmt.Errorf("RotateSKSOperatorsCA: new request: %w", err)
-	}
-
-	request.Header.Add("User-Agent", c.getUserAgent())
-
-	if err := c.executeRequestInterceptors(ctx, request); err != nil {
-		return nil, fmt.Errorf("RotateSKSOperatorsCA: execute request editors: %w", err)
-	}
-
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RotateSKSOperatorsCA: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "rotate-sks-operators-ca")
-	}
-
-	response, err := c.httpClient.Do(request)
+	request, err := http.NewRequestWithContext(ctx, "PUT", c.serverEndpoint+path, nil)
 	if err != nil {
-		return nil, fmt.Errorf("RotateSKSOperatorsCA: http client do: %w", err)
+		return nil, fmt.Errorf("RotateSKSOperatorsCA: new request: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
+	request.Header.Add("User-Agent", c.getUserAgent())
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("RotateSKSOperatorsCA: http response: %w", err)
+	if err := c.executeRequestInterceptors(ctx, request); err != nil {
+		return nil, fmt.Errorf("RotateSKSOperatorsCA: execute request editors: %w", err)
 	}
 
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("RotateSKSOperatorsCA: prepare JSON response: %w", err)
+	if err := c.do(ctx, "rotate-sks-operators-ca", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("RotateSKSOperatorsCA: %w", err)
 	}
 
 	return bodyresp, nil
@@ -18199,30 +13707,9 @@ func (c Client) UpgradeSKSCluster(ctx context.Context, id UUID, req UpgradeSKSCl
 		return nil, fmt.Errorf("UpgradeSKSCluster: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpgradeSKSCluster: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "upgrade-sks-cluster")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("UpgradeSKSCluster: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("UpgradeSKSCluster: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("UpgradeSKSCluster: prepare JSON response: %w", err)
+	if err := c.do(ctx, "upgrade-sks-cluster", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("UpgradeSKSCluster: %w", err)
 	}
 
 	return bodyresp, nil
@@ -18243,30 +13730,9 @@ func (c Client) UpgradeSKSClusterServiceLevel(ctx context.Context, id UUID) (*Op
 		return nil, fmt.Errorf("UpgradeSKSClusterServiceLevel: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpgradeSKSClusterServiceLevel: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "upgrade-sks-cluster-service-level")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("UpgradeSKSClusterServiceLevel: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("UpgradeSKSClusterServiceLevel: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("UpgradeSKSClusterServiceLevel: prepare JSON response: %w", err)
+	if err := c.do(ctx, "upgrade-sks-cluster-service-level", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("UpgradeSKSClusterServiceLevel: %w", err)
 	}
 
 	return bodyresp, nil
@@ -18298,30 +13764,9 @@ func (c Client) GetActiveNodepoolTemplate(ctx context.Context, kubeVersion strin
 		return nil, fmt.Errorf("GetActiveNodepoolTemplate: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetActiveNodepoolTemplate: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-active-nodepool-template")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetActiveNodepoolTemplate: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetActiveNodepoolTemplate: http response: %w", err)
-	}
-
 	bodyresp := new(GetActiveNodepoolTemplateResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetActiveNodepoolTemplate: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-active-nodepool-template", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetActiveNodepoolTemplate: %w", err)
 	}
 
 	return bodyresp, nil
@@ -18365,33 +13810,41 @@ func (c Client) ListSnapshots(ctx context.Context) (*ListSnapshotsResponse, erro
 		return nil, fmt.Errorf("ListSnapshots: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListSnapshots: sign request: %w", err)
+	bodyresp := new(ListSnapshotsResponse)
+	if err := c.do(ctx, "list-snapshots", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ListSnapshots: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "list-snapshots")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ListSnapshots: http client do: %w", err)
-	}
+// ListSnapshotsSeq returns an iterator over the elements listed by ListSnapshots,
+// decoded while the response is read. The iteration stops at the first error,
+// yielded with a zero element, or once the context is done.
+func (c Client) ListSnapshotsSeq(ctx context.Context) iter.Seq2[Snapshot, error] {
+	return func(yield func(Snapshot, error) bool) {
+		path := "/snapshot"
 
-	if c.trace {
-		dumpResponse(response)
-	}
+		request, err := http.NewRequestWithContext(ctx, "GET", c.serverEndpoint+path, nil)
+		if err != nil {
+			yieldError(yield, fmt.Errorf("ListSnapshotsSeq: new request: %w", err))
+			return
+		}
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ListSnapshots: http response: %w", err)
-	}
+		request.Header.Add("User-Agent", c.getUserAgent())
 
-	bodyresp := new(ListSnapshotsResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ListSnapshots: prepare JSON response: %w", err)
-	}
+		if err := c.executeRequestInterceptors(ctx, request); err != nil {
+			yieldError(yield, fmt.Errorf("ListSnapshotsSeq: execute request editors: %w", err))
+			return
+		}
 
-	return bodyresp, nil
+		bodyresp := new(ListSnapshotsResponse)
+		call := &Call{OperationID: "list-snapshots", Request: request, Result: bodyresp}
+		elems := func() []Snapshot { return bodyresp.Snapshots }
+		if err := streamList(ctx, c, call, true, "snapshots", elems, yield); err != nil {
+			yieldError(yield, fmt.Errorf("ListSnapshotsSeq: %w", err))
+		}
+	}
 }
 
 // Delete a Snapshot
@@ -18409,30 +13862,9 @@ func (c Client) DeleteSnapshot(ctx context.Context, id UUID) (*Operation, error)
 		return nil, fmt.Errorf("DeleteSnapshot: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteSnapshot: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-snapshot")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteSnapshot: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteSnapshot: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteSnapshot: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-snapshot", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteSnapshot: %w", err)
 	}
 
 	return bodyresp, nil
@@ -18453,30 +13885,9 @@ func (c Client) GetSnapshot(ctx context.Context, id UUID) (*Snapshot, error) {
 		return nil, fmt.Errorf("GetSnapshot: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetSnapshot: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-snapshot")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetSnapshot: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetSnapshot: http response: %w", err)
-	}
-
 	bodyresp := new(Snapshot)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetSnapshot: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-snapshot", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetSnapshot: %w", err)
 	}
 
 	return bodyresp, nil
@@ -18497,30 +13908,9 @@ func (c Client) ExportSnapshot(ctx context.Context, id UUID) (*Operation, error)
 		return nil, fmt.Errorf("ExportSnapshot: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ExportSnapshot: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "export-snapshot")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ExportSnapshot: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ExportSnapshot: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ExportSnapshot: prepare JSON response: %w", err)
+	if err := c.do(ctx, "export-snapshot", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ExportSnapshot: %w", err)
 	}
 
 	return bodyresp, nil
@@ -18561,30 +13951,9 @@ func (c Client) PromoteSnapshotToTemplate(ctx context.Context, id UUID, req Prom
 		return nil, fmt.Errorf("PromoteSnapshotToTemplate: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("PromoteSnapshotToTemplate: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "promote-snapshot-to-template")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("PromoteSnapshotToTemplate: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("PromoteSnapshotToTemplate: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("PromoteSnapshotToTemplate: prepare JSON response: %w", err)
+	if err := c.do(ctx, "promote-snapshot-to-template", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("PromoteSnapshotToTemplate: %w", err)
 	}
 
 	return bodyresp, nil
@@ -18628,33 +13997,41 @@ func (c Client) ListSOSBucketsUsage(ctx context.Context) (*ListSOSBucketsUsageRe
 		return nil, fmt.Errorf("ListSOSBucketsUsage: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListSOSBucketsUsage: sign request: %w", err)
+	bodyresp := new(ListSOSBucketsUsageResponse)
+	if err := c.do(ctx, "list-sos-buckets-usage", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ListSOSBucketsUsage: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "list-sos-buckets-usage")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ListSOSBucketsUsage: http client do: %w", err)
-	}
+// ListSOSBucketsUsageSeq returns an iterator over the elements listed by ListSOSBucketsUsage,
+// decoded while the response is read. The iteration stops at the first error,
+// yielded with a zero element, or once the context is done.
+func (c Client) ListSOSBucketsUsageSeq(ctx context.Context) iter.Seq2[SOSBucketUsage, error] {
+	return func(yield func(SOSBucketUsage, error) bool) {
+		path := "/sos-buckets-usage"
 
-	if c.trace {
-		dumpResponse(response)
-	}
+		request, err := http.NewRequestWithContext(ctx, "GET", c.serverEndpoint+path, nil)
+		if err != nil {
+			yieldError(yield, fmt.Errorf("ListSOSBucketsUsageSeq: new request: %w", err))
+			return
+		}
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ListSOSBucketsUsage: http response: %w", err)
-	}
+		request.Header.Add("User-Agent", c.getUserAgent())
 
-	bodyresp := new(ListSOSBucketsUsageResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ListSOSBucketsUsage: prepare JSON response: %w", err)
-	}
+		if err := c.executeRequestInterceptors(ctx, request); err != nil {
+			yieldError(yield, fmt.Errorf("ListSOSBucketsUsageSeq: execute request editors: %w", err))
+			return
+		}
 
-	return bodyresp, nil
+		bodyresp := new(ListSOSBucketsUsageResponse)
+		call := &Call{OperationID: "list-sos-buckets-usage", Request: request, Result: bodyresp}
+		elems := func() []SOSBucketUsage { return bodyresp.SOSBucketsUsage }
+		if err := streamList(ctx, c, call, true, "sos-buckets-usage", elems, yield); err != nil {
+			yieldError(yield, fmt.Errorf("ListSOSBucketsUsageSeq: %w", err))
+		}
+	}
 }
 
 type GetSOSPresignedURLResponse struct {
@@ -18692,30 +14069,9 @@ func (c Client) GetSOSPresignedURL(ctx context.Context, bucket string, opts ...G
 		return nil, fmt.Errorf("GetSOSPresignedURL: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetSOSPresignedURL: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-sos-presigned-url")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetSOSPresignedURL: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetSOSPresignedURL: http response: %w", err)
-	}
-
 	bodyresp := new(GetSOSPresignedURLResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetSOSPresignedURL: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-sos-presigned-url", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetSOSPresignedURL: %w", err)
 	}
 
 	return bodyresp, nil
@@ -18759,33 +14115,41 @@ func (c Client) ListSSHKeys(ctx context.Context) (*ListSSHKeysResponse, error) {
 		return nil, fmt.Errorf("ListSSHKeys: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListSSHKeys: sign request: %w", err)
+	bodyresp := new(ListSSHKeysResponse)
+	if err := c.do(ctx, "list-ssh-keys", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ListSSHKeys: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "list-ssh-keys")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ListSSHKeys: http client do: %w", err)
-	}
+// ListSSHKeysSeq returns an iterator over the elements listed by ListSSHKeys,
+// decoded while the response is read. The iteration stops at the first error,
+// yielded with a zero element, or once the context is done.
+func (c Client) ListSSHKeysSeq(ctx context.Context) iter.Seq2[SSHKey, error] {
+	return func(yield func(SSHKey, error) bool) {
+		path := "/ssh-key"
 
-	if c.trace {
-		dumpResponse(response)
-	}
+		request, err := http.NewRequestWithContext(ctx, "GET", c.serverEndpoint+path, nil)
+		if err != nil {
+			yieldError(yield, fmt.Errorf("ListSSHKeysSeq: new request: %w", err))
+			return
+		}
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ListSSHKeys: http response: %w", err)
-	}
+		request.Header.Add("User-Agent", c.getUserAgent())
 
-	bodyresp := new(ListSSHKeysResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ListSSHKeys: prepare JSON response: %w", err)
-	}
+		if err := c.executeRequestInterceptors(ctx, request); err != nil {
+			yieldError(yield, fmt.Errorf("ListSSHKeysSeq: execute request editors: %w", err))
+			return
+		}
 
-	return bodyresp, nil
+		bodyresp := new(ListSSHKeysResponse)
+		call := &Call{OperationID: "list-ssh-keys", Request: request, Result: bodyresp}
+		elems := func() []SSHKey { return bodyresp.SSHKeys }
+		if err := streamList(ctx, c, call, true, "ssh-keys", elems, yield); err != nil {
+			yieldError(yield, fmt.Errorf("ListSSHKeysSeq: %w", err))
+		}
+	}
 }
 
 type RegisterSSHKeyRequest struct {
@@ -18817,30 +14181,9 @@ func (c Client) RegisterSSHKey(ctx context.Context, req RegisterSSHKeyRequest) (
 		return nil, fmt.Errorf("RegisterSSHKey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RegisterSSHKey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "register-ssh-key")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("RegisterSSHKey: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("RegisterSSHKey: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("RegisterSSHKey: prepare JSON response: %w", err)
+	if err := c.do(ctx, "register-ssh-key", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("RegisterSSHKey: %w", err)
 	}
 
 	return bodyresp, nil
@@ -18861,30 +14204,9 @@ func (c Client) DeleteSSHKey(ctx context.Context, name string) (*Operation, erro
 		return nil, fmt.Errorf("DeleteSSHKey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteSSHKey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-ssh-key")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteSSHKey: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteSSHKey: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteSSHKey: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-ssh-key", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteSSHKey: %w", err)
 	}
 
 	return bodyresp, nil
@@ -18905,30 +14227,9 @@ func (c Client) GetSSHKey(ctx context.Context, name string) (*SSHKey, error) {
 		return nil, fmt.Errorf("GetSSHKey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetSSHKey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-ssh-key")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetSSHKey: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetSSHKey: http response: %w", err)
-	}
-
 	bodyresp := new(SSHKey)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetSSHKey: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-ssh-key", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetSSHKey: %w", err)
 	}
 
 	return bodyresp, nil
@@ -19001,33 +14302,49 @@ func (c Client) ListTemplates(ctx context.Context, opts ...ListTemplatesOpt) (*L
 		return nil, fmt.Errorf("ListTemplates: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListTemplates: sign request: %w", err)
+	bodyresp := new(ListTemplatesResponse)
+	if err := c.do(ctx, "list-templates", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ListTemplates: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "list-templates")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ListTemplates: http client do: %w", err)
-	}
+// ListTemplatesSeq returns an iterator over the elements listed by ListTemplates,
+// decoded while the response is read. The iteration stops at the first error,
+// yielded with a zero element, or once the context is done.
+func (c Client) ListTemplatesSeq(ctx context.Context, opts ...ListTemplatesOpt) iter.Seq2[Template, error] {
+	return func(yield func(Template, error) bool) {
+		path := "/template"
 
-	if c.trace {
-		dumpResponse(response)
-	}
+		request, err := http.NewRequestWithContext(ctx, "GET", c.serverEndpoint+path, nil)
+		if err != nil {
+			yieldError(yield, fmt.Errorf("ListTemplatesSeq: new request: %w", err))
+			return
+		}
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ListTemplates: http response: %w", err)
-	}
+		request.Header.Add("User-Agent", c.getUserAgent())
 
-	bodyresp := new(ListTemplatesResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ListTemplates: prepare JSON response: %w", err)
-	}
+		if len(opts) > 0 {
+			q := request.URL.Query()
+			for _, opt := range opts {
+				opt(q)
+			}
+			request.URL.RawQuery = q.Encode()
+		}
 
-	return bodyresp, nil
+		if err := c.executeRequestInterceptors(ctx, request); err != nil {
+			yieldError(yield, fmt.Errorf("ListTemplatesSeq: execute request editors: %w", err))
+			return
+		}
+
+		bodyresp := new(ListTemplatesResponse)
+		call := &Call{OperationID: "list-templates", Request: request, Result: bodyresp}
+		elems := func() []Template { return bodyresp.Templates }
+		if err := streamList(ctx, c, call, true, "templates", elems, yield); err != nil {
+			yieldError(yield, fmt.Errorf("ListTemplatesSeq: %w", err))
+		}
+	}
 }
 
 type RegisterTemplateRequestBootMode string
@@ -19088,30 +14405,9 @@ func (c Client) RegisterTemplate(ctx context.Context, req RegisterTemplateReques
 		return nil, fmt.Errorf("RegisterTemplate: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RegisterTemplate: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "register-template")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("RegisterTemplate: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("RegisterTemplate: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("RegisterTemplate: prepare JSON response: %w", err)
+	if err := c.do(ctx, "register-template", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("RegisterTemplate: %w", err)
 	}
 
 	return bodyresp, nil
@@ -19132,30 +14428,9 @@ func (c Client) DeleteTemplate(ctx context.Context, id UUID) (*Operation, error)
 		return nil, fmt.Errorf("DeleteTemplate: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteTemplate: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-template")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteTemplate: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteTemplate: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteTemplate: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-template", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteTemplate: %w", err)
 	}
 
 	return bodyresp, nil
@@ -19176,30 +14451,9 @@ func (c Client) GetTemplate(ctx context.Context, id UUID) (*Template, error) {
 		return nil, fmt.Errorf("GetTemplate: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetTemplate: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-template")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetTemplate: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetTemplate: http response: %w", err)
-	}
-
 	bodyresp := new(Template)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetTemplate: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-template", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetTemplate: %w", err)
 	}
 
 	return bodyresp, nil
@@ -19232,30 +14486,9 @@ func (c Client) CopyTemplate(ctx context.Context, id UUID, req CopyTemplateReque
 		return nil, fmt.Errorf("CopyTemplate: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CopyTemplate: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "copy-template")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("CopyTemplate: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CopyTemplate: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CopyTemplate: prepare JSON response: %w", err)
+	if err := c.do(ctx, "copy-template", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CopyTemplate: %w", err)
 	}
 
 	return bodyresp, nil
@@ -19290,33 +14523,37 @@ func (c Client) UpdateTemplate(ctx context.Context, id UUID, req UpdateTemplateR
 		return nil, fmt.Errorf("UpdateTemplate: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateTemplate: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "update-template", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateTemplate: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "update-template")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// UpdateTemplateAndWait calls UpdateTemplate, waits for the operation to succeed
+// and returns the updated resource.
+func (c Client) UpdateTemplateAndWait(ctx context.Context, id UUID, req UpdateTemplateRequest) (*Template, error) {
+	op, err := c.UpdateTemplate(ctx, id, req)
 	if err != nil {
-		return nil, fmt.Errorf("UpdateTemplate: http client do: %w", err)
+		return nil, fmt.Errorf("UpdateTemplateAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("UpdateTemplate: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateTemplateAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("UpdateTemplate: prepare JSON response: %w", err)
+	resp, err := c.GetTemplate(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateTemplateAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 // Usage
@@ -19365,37 +14602,16 @@ func (c Client) GetUsageReport(ctx context.Context, opts ...GetUsageReportOpt) (
 		for _, opt := range opts {
 			opt(q)
 		}
-		request.URL.RawQuery = q.Encode()
-	}
-
-	if err := c.executeRequestInterceptors(ctx, request); err != nil {
-		return nil, fmt.Errorf("GetUsageReport: execute request editors: %w", err)
-	}
-
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetUsageReport: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-usage-report")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetUsageReport: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
+		request.URL.RawQuery = q.Encode()
 	}
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetUsageReport: http response: %w", err)
+	if err := c.executeRequestInterceptors(ctx, request); err != nil {
+		return nil, fmt.Errorf("GetUsageReport: execute request editors: %w", err)
 	}
 
 	bodyresp := new(GetUsageReportResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetUsageReport: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-usage-report", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetUsageReport: %w", err)
 	}
 
 	return bodyresp, nil
@@ -19438,33 +14654,41 @@ func (c Client) ListUsers(ctx context.Context) (*ListUsersResponse, error) {
 		return nil, fmt.Errorf("ListUsers: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListUsers: sign request: %w", err)
+	bodyresp := new(ListUsersResponse)
+	if err := c.do(ctx, "list-users", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ListUsers: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "list-users")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ListUsers: http client do: %w", err)
-	}
+// ListUsersSeq returns an iterator over the elements listed by ListUsers,
+// decoded while the response is read. The iteration stops at the first error,
+// yielded with a zero element, or once the context is done.
+func (c Client) ListUsersSeq(ctx context.Context) iter.Seq2[User, error] {
+	return func(yield func(User, error) bool) {
+		path := "/user"
 
-	if c.trace {
-		dumpResponse(response)
-	}
+		request, err := http.NewRequestWithContext(ctx, "GET", c.serverEndpoint+path, nil)
+		if err != nil {
+			yieldError(yield, fmt.Errorf("ListUsersSeq: new request: %w", err))
+			return
+		}
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ListUsers: http response: %w", err)
-	}
+		request.Header.Add("User-Agent", c.getUserAgent())
 
-	bodyresp := new(ListUsersResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ListUsers: prepare JSON response: %w", err)
-	}
+		if err := c.executeRequestInterceptors(ctx, request); err != nil {
+			yieldError(yield, fmt.Errorf("ListUsersSeq: execute request editors: %w", err))
+			return
+		}
 
-	return bodyresp, nil
+		bodyresp := new(ListUsersResponse)
+		call := &Call{OperationID: "list-users", Request: request, Result: bodyresp}
+		elems := func() []User { return bodyresp.Users }
+		if err := streamList(ctx, c, call, true, "users", elems, yield); err != nil {
+			yieldError(yield, fmt.Errorf("ListUsersSeq: %w", err))
+		}
+	}
 }
 
 type CreateUserRequest struct {
@@ -19495,30 +14719,9 @@ func (c Client) CreateUser(ctx context.Context, req CreateUserRequest) (*Operati
 		return nil, fmt.Errorf("CreateUser: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateUser: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-user")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("CreateUser: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateUser: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateUser: prepare JSON response: %w", err)
+	if err := c.do(ctx, "create-user", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateUser: %w", err)
 	}
 
 	return bodyresp, nil
@@ -19538,30 +14741,9 @@ func (c Client) DeleteUser(ctx context.Context, id UUID) (*Operation, error) {
 		return nil, fmt.Errorf("DeleteUser: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteUser: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-user")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteUser: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteUser: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteUser: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-user", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteUser: %w", err)
 	}
 
 	return bodyresp, nil
@@ -19593,30 +14775,9 @@ func (c Client) UpdateUserRole(ctx context.Context, id UUID, req UpdateUserRoleR
 		return nil, fmt.Errorf("UpdateUserRole: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateUserRole: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-user-role")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("UpdateUserRole: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("UpdateUserRole: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("UpdateUserRole: prepare JSON response: %w", err)
+	if err := c.do(ctx, "update-user-role", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateUserRole: %w", err)
 	}
 
 	return bodyresp, nil
@@ -19660,33 +14821,41 @@ func (c Client) ListVpcs(ctx context.Context) (*ListVpcsResponse, error) {
 		return nil, fmt.Errorf("ListVpcs: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListVpcs: sign request: %w", err)
+	bodyresp := new(ListVpcsResponse)
+	if err := c.do(ctx, "list-vpcs", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ListVpcs: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "list-vpcs")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ListVpcs: http client do: %w", err)
-	}
+// ListVpcsSeq returns an iterator over the elements listed by ListVpcs,
+// decoded while the response is read. The iteration stops at the first error,
+// yielded with a zero element, or once the context is done.
+func (c Client) ListVpcsSeq(ctx context.Context) iter.Seq2[ListVpcEntry, error] {
+	return func(yield func(ListVpcEntry, error) bool) {
+		path := "/vpc"
 
-	if c.trace {
-		dumpResponse(response)
-	}
+		request, err := http.NewRequestWithContext(ctx, "GET", c.serverEndpoint+path, nil)
+		if err != nil {
+			yieldError(yield, fmt.Errorf("ListVpcsSeq: new request: %w", err))
+			return
+		}
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ListVpcs: http response: %w", err)
-	}
+		request.Header.Add("User-Agent", c.getUserAgent())
 
-	bodyresp := new(ListVpcsResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ListVpcs: prepare JSON response: %w", err)
-	}
+		if err := c.executeRequestInterceptors(ctx, request); err != nil {
+			yieldError(yield, fmt.Errorf("ListVpcsSeq: execute request editors: %w", err))
+			return
+		}
 
-	return bodyresp, nil
+		bodyresp := new(ListVpcsResponse)
+		call := &Call{OperationID: "list-vpcs", Request: request, Result: bodyresp}
+		elems := func() []ListVpcEntry { return bodyresp.Vpcs }
+		if err := streamList(ctx, c, call, true, "vpcs", elems, yield); err != nil {
+			yieldError(yield, fmt.Errorf("ListVpcsSeq: %w", err))
+		}
+	}
 }
 
 type CreateVpcRequest struct {
@@ -19719,33 +14888,40 @@ func (c Client) CreateVpc(ctx context.Context, req CreateVpcRequest) (*Operation
 		return nil, fmt.Errorf("CreateVpc: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateVpc: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "create-vpc", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateVpc: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "create-vpc")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// CreateVpcAndWait calls CreateVpc, waits for the operation to succeed
+// and returns the created resource.
+func (c Client) CreateVpcAndWait(ctx context.Context, req CreateVpcRequest) (*Vpc, error) {
+	op, err := c.CreateVpc(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("CreateVpc: http client do: %w", err)
+		return nil, fmt.Errorf("CreateVpcAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateVpc: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("CreateVpcAndWait: %w", &OperationError{ID: op.ID, Err: err})
+	}
+	if done.Reference == nil {
+		return nil, fmt.Errorf("CreateVpcAndWait: %w", &OperationError{ID: op.ID, Err: ErrMissingOperationReference})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateVpc: prepare JSON response: %w", err)
+	resp, err := c.GetVpc(ctx, done.Reference.ID)
+	if err != nil {
+		return nil, fmt.Errorf("CreateVpcAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 // [BETA] Delete a VPC
@@ -19763,30 +14939,9 @@ func (c Client) DeleteVpc(ctx context.Context, id UUID) (*Empty, error) {
 		return nil, fmt.Errorf("DeleteVpc: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteVpc: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-vpc")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteVpc: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteVpc: http response: %w", err)
-	}
-
 	bodyresp := new(Empty)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteVpc: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-vpc", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteVpc: %w", err)
 	}
 
 	return bodyresp, nil
@@ -19807,30 +14962,9 @@ func (c Client) GetVpc(ctx context.Context, id UUID) (*Vpc, error) {
 		return nil, fmt.Errorf("GetVpc: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetVpc: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-vpc")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetVpc: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetVpc: http response: %w", err)
-	}
-
 	bodyresp := new(Vpc)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetVpc: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-vpc", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetVpc: %w", err)
 	}
 
 	return bodyresp, nil
@@ -19866,30 +15000,9 @@ func (c Client) UpdateVpc(ctx context.Context, id UUID, req UpdateVpcRequest) (*
 		return nil, fmt.Errorf("UpdateVpc: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateVpc: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-vpc")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("UpdateVpc: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("UpdateVpc: http response: %w", err)
-	}
-
 	bodyresp := new(Vpc)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("UpdateVpc: prepare JSON response: %w", err)
+	if err := c.do(ctx, "update-vpc", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateVpc: %w", err)
 	}
 
 	return bodyresp, nil
@@ -19933,33 +15046,41 @@ func (c Client) ListVpcRoutes(ctx context.Context, vpcID UUID) (*ListVpcRoutesRe
 		return nil, fmt.Errorf("ListVpcRoutes: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListVpcRoutes: sign request: %w", err)
+	bodyresp := new(ListVpcRoutesResponse)
+	if err := c.do(ctx, "list-vpc-routes", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ListVpcRoutes: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "list-vpc-routes")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ListVpcRoutes: http client do: %w", err)
-	}
+// ListVpcRoutesSeq returns an iterator over the elements listed by ListVpcRoutes,
+// decoded while the response is read. The iteration stops at the first error,
+// yielded with a zero element, or once the context is done.
+func (c Client) ListVpcRoutesSeq(ctx context.Context, vpcID UUID) iter.Seq2[ListRouteEntry, error] {
+	return func(yield func(ListRouteEntry, error) bool) {
+		path := fmt.Sprintf("/vpc/%v/route", vpcID)
 
-	if c.trace {
-		dumpResponse(response)
-	}
+		request, err := http.NewRequestWithContext(ctx, "GET", c.serverEndpoint+path, nil)
+		if err != nil {
+			yieldError(yield, fmt.Errorf("ListVpcRoutesSeq: new request: %w", err))
+			return
+		}
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ListVpcRoutes: http response: %w", err)
-	}
+		request.Header.Add("User-Agent", c.getUserAgent())
 
-	bodyresp := new(ListVpcRoutesResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ListVpcRoutes: prepare JSON response: %w", err)
-	}
+		if err := c.executeRequestInterceptors(ctx, request); err != nil {
+			yieldError(yield, fmt.Errorf("ListVpcRoutesSeq: execute request editors: %w", err))
+			return
+		}
 
-	return bodyresp, nil
+		bodyresp := new(ListVpcRoutesResponse)
+		call := &Call{OperationID: "list-vpc-routes", Request: request, Result: bodyresp}
+		elems := func() []ListRouteEntry { return bodyresp.Routes }
+		if err := streamList(ctx, c, call, true, "routes", elems, yield); err != nil {
+			yieldError(yield, fmt.Errorf("ListVpcRoutesSeq: %w", err))
+		}
+	}
 }
 
 type ListSubnetsResponse struct {
@@ -20000,33 +15121,41 @@ func (c Client) ListSubnets(ctx context.Context, vpcID UUID) (*ListSubnetsRespon
 		return nil, fmt.Errorf("ListSubnets: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListSubnets: sign request: %w", err)
+	bodyresp := new(ListSubnetsResponse)
+	if err := c.do(ctx, "list-subnets", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ListSubnets: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "list-subnets")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ListSubnets: http client do: %w", err)
-	}
+// ListSubnetsSeq returns an iterator over the elements listed by ListSubnets,
+// decoded while the response is read. The iteration stops at the first error,
+// yielded with a zero element, or once the context is done.
+func (c Client) ListSubnetsSeq(ctx context.Context, vpcID UUID) iter.Seq2[ListSubnetEntry, error] {
+	return func(yield func(ListSubnetEntry, error) bool) {
+		path := fmt.Sprintf("/vpc/%v/subnet", vpcID)
 
-	if c.trace {
-		dumpResponse(response)
-	}
+		request, err := http.NewRequestWithContext(ctx, "GET", c.serverEndpoint+path, nil)
+		if err != nil {
+			yieldError(yield, fmt.Errorf("ListSubnetsSeq: new request: %w", err))
+			return
+		}
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ListSubnets: http response: %w", err)
-	}
+		request.Header.Add("User-Agent", c.getUserAgent())
 
-	bodyresp := new(ListSubnetsResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ListSubnets: prepare JSON response: %w", err)
-	}
+		if err := c.executeRequestInterceptors(ctx, request); err != nil {
+			yieldError(yield, fmt.Errorf("ListSubnetsSeq: execute request editors: %w", err))
+			return
+		}
 
-	return bodyresp, nil
+		bodyresp := new(ListSubnetsResponse)
+		call := &Call{OperationID: "list-subnets", Request: request, Result: bodyresp}
+		elems := func() []ListSubnetEntry { return bodyresp.Subnets }
+		if err := streamList(ctx, c, call, true, "subnets", elems, yield); err != nil {
+			yieldError(yield, fmt.Errorf("ListSubnetsSeq: %w", err))
+		}
+	}
 }
 
 type CreateSubnetRequestAddressSpace string
@@ -20077,33 +15206,40 @@ func (c Client) CreateSubnet(ctx context.Context, vpcID UUID, req CreateSubnetRe
 		return nil, fmt.Errorf("CreateSubnet: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateSubnet: sign request: %w", err)
+	bodyresp := new(Operation)
+	if err := c.do(ctx, "create-subnet", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateSubnet: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "create-subnet")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
+// CreateSubnetAndWait calls CreateSubnet, waits for the operation to succeed
+// and returns the created resource.
+func (c Client) CreateSubnetAndWait(ctx context.Context, vpcID UUID, req CreateSubnetRequest) (*Subnet, error) {
+	op, err := c.CreateSubnet(ctx, vpcID, req)
 	if err != nil {
-		return nil, fmt.Errorf("CreateSubnet: http client do: %w", err)
+		return nil, fmt.Errorf("CreateSubnetAndWait: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	done, err := c.Wait(ctx, op, OperationStateSuccess)
+	// Operations already in a final state are returned as is by Wait.
+	if err == nil && done.State != OperationStateSuccess {
+		err = newOperationFailedError(done)
 	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateSubnet: http response: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("CreateSubnetAndWait: %w", &OperationError{ID: op.ID, Err: err})
+	}
+	if done.Reference == nil {
+		return nil, fmt.Errorf("CreateSubnetAndWait: %w", &OperationError{ID: op.ID, Err: ErrMissingOperationReference})
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateSubnet: prepare JSON response: %w", err)
+	resp, err := c.GetSubnet(ctx, vpcID, done.Reference.ID)
+	if err != nil {
+		return nil, fmt.Errorf("CreateSubnetAndWait: %w", &OperationError{ID: op.ID, Err: err})
 	}
 
-	return bodyresp, nil
+	return resp, nil
 }
 
 // [BETA] Delete a Subnet
@@ -20121,30 +15257,9 @@ func (c Client) DeleteSubnet(ctx context.Context, vpcID UUID, id UUID) (*Empty,
 		return nil, fmt.Errorf("DeleteSubnet: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteSubnet: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-subnet")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteSubnet: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteSubnet: http response: %w", err)
-	}
-
 	bodyresp := new(Empty)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteSubnet: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-subnet", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteSubnet: %w", err)
 	}
 
 	return bodyresp, nil
@@ -20165,30 +15280,9 @@ func (c Client) GetSubnet(ctx context.Context, vpcID UUID, id UUID) (*Subnet, er
 		return nil, fmt.Errorf("GetSubnet: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetSubnet: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-subnet")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetSubnet: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetSubnet: http response: %w", err)
-	}
-
 	bodyresp := new(Subnet)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetSubnet: prepare JSON response: %w", err)
+	if err := c.do(ctx, "get-subnet", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetSubnet: %w", err)
 	}
 
 	return bodyresp, nil
@@ -20226,30 +15320,9 @@ func (c Client) UpdateSubnet(ctx context.Context, vpcID UUID, id UUID, req Updat
 		return nil, fmt.Errorf("UpdateSubnet: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateSubnet: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-subnet")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("UpdateSubnet: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("UpdateSubnet: http response: %w", err)
-	}
-
 	bodyresp := new(Subnet)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("UpdateSubnet: prepare JSON response: %w", err)
+	if err := c.do(ctx, "update-subnet", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateSubnet: %w", err)
 	}
 
 	return bodyresp, nil
@@ -20284,30 +15357,9 @@ func (c Client) AttachInstanceToSubnet(ctx context.Context, vpcID UUID, subnetID
 		return nil, fmt.Errorf("AttachInstanceToSubnet: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("AttachInstanceToSubnet: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "attach-instance-to-subnet")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("AttachInstanceToSubnet: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("AttachInstanceToSubnet: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("AttachInstanceToSubnet: prepare JSON response: %w", err)
+	if err := c.do(ctx, "attach-instance-to-subnet", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("AttachInstanceToSubnet: %w", err)
 	}
 
 	return bodyresp, nil
@@ -20340,30 +15392,9 @@ func (c Client) DetachInstanceFromSubnet(ctx context.Context, vpcID UUID, subnet
 		return nil, fmt.Errorf("DetachInstanceFromSubnet: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DetachInstanceFromSubnet: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "detach-instance-from-subnet")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DetachInstanceFromSubnet: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DetachInstanceFromSubnet: http response: %w", err)
-	}
-
 	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DetachInstanceFromSubnet: prepare JSON response: %w", err)
+	if err := c.do(ctx, "detach-instance-from-subnet", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DetachInstanceFromSubnet: %w", err)
 	}
 
 	return bodyresp, nil
@@ -20407,33 +15438,41 @@ func (c Client) ListRoutes(ctx context.Context, vpcID UUID, subnetID UUID) (*Lis
 		return nil, fmt.Errorf("ListRoutes: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListRoutes: sign request: %w", err)
+	bodyresp := new(ListRoutesResponse)
+	if err := c.do(ctx, "list-routes", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("ListRoutes: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "list-routes")
-	}
+	return bodyresp, nil
+}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ListRoutes: http client do: %w", err)
-	}
+// ListRoutesSeq returns an iterator over the elements listed by ListRoutes,
+// decoded while the response is read. The iteration stops at the first error,
+// yielded with a zero element, or once the context is done.
+func (c Client) ListRoutesSeq(ctx context.Context, vpcID UUID, subnetID UUID) iter.Seq2[ListRouteEntry, error] {
+	return func(yield func(ListRouteEntry, error) bool) {
+		path := fmt.Sprintf("/vpc/%v/subnet/%v/route", vpcID, subnetID)
 
-	if c.trace {
-		dumpResponse(response)
-	}
+		request, err := http.NewRequestWithContext(ctx, "GET", c.serverEndpoint+path, nil)
+		if err != nil {
+			yieldError(yield, fmt.Errorf("ListRoutesSeq: new request: %w", err))
+			return
+		}
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ListRoutes: http response: %w", err)
-	}
+		request.Header.Add("User-Agent", c.getUserAgent())
 
-	bodyresp := new(ListRoutesResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ListRoutes: prepare JSON response: %w", err)
-	}
+		if err := c.executeRequestInterceptors(ctx, request); err != nil {
+			yieldError(yield, fmt.Errorf("ListRoutesSeq: execute request editors: %w", err))
+			return
+		}
 
-	return bodyresp, nil
+		bodyresp := new(ListRoutesResponse)
+		call := &Call{OperationID: "list-routes", Request: request, Result: bodyresp}
+		elems := func() []ListRouteEntry { return bodyresp.Routes }
+		if err := streamList(ctx, c, call, true, "routes", elems, yield); err != nil {
+			yieldError(yield, fmt.Errorf("ListRoutesSeq: %w", err))
+		}
+	}
 }
 
 type CreateRouteRequest struct {
@@ -20467,30 +15506,9 @@ func (c Client) CreateRoute(ctx context.Context, vpcID UUID, subnetID UUID, req
 		return nil, fmt.Errorf("CreateRoute: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateRoute: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-route")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("CreateRoute: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateRoute: http response: %w", err)
-	}
-
 	bodyresp := new(Route)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateRoute: prepare JSON response: %w", err)
+	if err := c.do(ctx, "create-route", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateRoute: %w", err)
 	}
 
 	return bodyresp, nil
@@ -20511,30 +15529,9 @@ func (c Client) DeleteRoute(ctx context.Context, vpcID UUID, subnetID UUID, id U
 		return nil, fmt.Errorf("DeleteRoute: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteRoute: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-route")
-	}
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteRoute: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("DeleteRoute: http response: %w", err)
-	}
-
 	bodyresp := new(Empty)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("DeleteRoute: prepare JSON response: %w", err)
+	if err := c.do(ctx, "delete-route", request, true, bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteRoute: %w", err)
 	}
 
 	return bodyresp, nil
@@ -20578,27 +15575,39 @@ func (c Client) ListZones(ctx context.Context) (*ListZonesResponse, error) {
 		return nil, fmt.Errorf("ListZones: execute request editors: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "list-zones")
+	bodyresp := new(ListZonesResponse)
+	if err := c.do(ctx, "list-zones", request, false, bodyresp); err != nil {
+		return nil, fmt.Errorf("ListZones: %w", err)
 	}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("ListZones: http client do: %w", err)
-	}
+	return bodyresp, nil
+}
 
-	if c.trace {
-		dumpResponse(response)
-	}
+// ListZonesSeq returns an iterator over the elements listed by ListZones,
+// decoded while the response is read. The iteration stops at the first error,
+// yielded with a zero element, or once the context is done.
+func (c Client) ListZonesSeq(ctx context.Context) iter.Seq2[Zone, error] {
+	return func(yield func(Zone, error) bool) {
+		path := "/zone"
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("ListZones: http response: %w", err)
-	}
+		request, err := http.NewRequestWithContext(ctx, "GET", c.serverEndpoint+path, nil)
+		if err != nil {
+			yieldError(yield, fmt.Errorf("ListZonesSeq: new request: %w", err))
+			return
+		}
 
-	bodyresp := new(ListZonesResponse)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("ListZones: prepare JSON response: %w", err)
-	}
+		request.Header.Add("User-Agent", c.getUserAgent())
 
-	return bodyresp, nil
+		if err := c.executeRequestInterceptors(ctx, request); err != nil {
+			yieldError(yield, fmt.Errorf("ListZonesSeq: execute request editors: %w", err))
+			return
+		}
+
+		bodyresp := new(ListZonesResponse)
+		call := &Call{OperationID: "list-zones", Request: request, Result: bodyresp}
+		elems := func() []Zone { return bodyresp.Zones }
+		if err := streamList(ctx, c, call, false, "zones", elems, yield); err != nil {
+			yieldError(yield, fmt.Errorf("ListZonesSeq: %w", err))
+		}
+	}
 }
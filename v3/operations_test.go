@@ -0,0 +1,49 @@
+package v3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestCreateAndWait(t *testing.T) {
+	const (
+		opID       = UUID("d3e8d1a6-7a3c-4d5e-9b1f-2d4c5e6f7a8b")
+		instanceID = UUID("bb2dcf4a-3e1b-4ce9-8f3c-6a8b1d5f0a27")
+	)
+
+	var state OperationState
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.Path {
+		case "POST /instance":
+			_, _ = fmt.Fprintf(w, `{"id":%q,"state":%q,"reference":{"id":%q}}`, opID, state, instanceID)
+		case "GET /instance/" + string(instanceID):
+			_, _ = fmt.Fprintf(w, `{"id":%q,"name":"test"}`, instanceID)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	state = OperationStateSuccess
+	instance, err := client.CreateInstanceAndWait(context.Background(), CreateInstanceRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if instance.ID != instanceID || instance.Name != "test" {
+		t.Errorf("unexpected instance %+v", instance)
+	}
+
+	state = OperationStateFailure
+	_, err = client.CreateInstanceAndWait(context.Background(), CreateInstanceRequest{})
+	var opErr *OperationError
+	if !errors.As(err, &opErr) || opErr.ID != opID {
+		t.Fatalf("expected an *OperationError for %q, got %v", opID, err)
+	}
+	var failedErr *OperationFailedError
+	if !errors.As(err, &failedErr) || failedErr.State != OperationStateFailure {
+		t.Errorf("expected an *OperationFailedError, got %v", err)
+	}
+}
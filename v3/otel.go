@@ -0,0 +1,267 @@
+package v3
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName is the OpenTelemetry instrumentation scope of the Exoscale API client.
+const instrumentationName = "github.com/exoscale/egoscale/v3"
+
+// OpenTelemetry attribute keys set on API operation spans and metrics.
+const (
+	attrOperation  = attribute.Key("exoscale.api.operation")
+	attrZone       = attribute.Key("exoscale.zone")
+	attrResourceID = attribute.Key("exoscale.resource.id")
+	attrRetries    = attribute.Key("exoscale.api.retries")
+	attrOpID       = attribute.Key("exoscale.operation.id")
+	attrOpState    = attribute.Key("exoscale.operation.state")
+	attrMethod     = attribute.Key("http.request.method")
+	attrStatusCode = attribute.Key("http.response.status_code")
+	attrServer     = attribute.Key("server.address")
+	attrErrorType  = attribute.Key("error.type")
+)
+
+var uuidPathSegment = regexp.MustCompile(`(?i)^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+
+// ClientOptWithTracerProvider returns a ClientOpt creating an OpenTelemetry span
+// for every API operation and Client.Wait call with the given TracerProvider.
+func ClientOptWithTracerProvider(tp trace.TracerProvider) ClientOpt {
+	return func(c *Client) error {
+		c.tracer = tp.Tracer(instrumentationName, trace.WithInstrumentationVersion(Version))
+		return nil
+	}
+}
+
+// ClientOptWithMeterProvider returns a ClientOpt recording OpenTelemetry metrics
+// of API operations (request count and latency) with the given MeterProvider.
+func ClientOptWithMeterProvider(mp metric.MeterProvider) ClientOpt {
+	return func(c *Client) error {
+		m, err := newAPIMetrics(mp)
+		if err != nil {
+			return err
+		}
+
+		c.metrics = m
+		return nil
+	}
+}
+
+// apiMetrics holds the metric instruments of API operations.
+type apiMetrics struct {
+	requests metric.Int64Counter
+	duration metric.Float64Histogram
+}
+
+func newAPIMetrics(mp metric.MeterProvider) (*apiMetrics, error) {
+	meter := mp.Meter(instrumentationName, metric.WithInstrumentationVersion(Version))
+
+	requests, err := meter.Int64Counter(
+		"exoscale.api.requests",
+		metric.WithDescription("Number of Exoscale API operations."),
+		metric.WithUnit("{request}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	duration, err := meter.Float64Histogram(
+		"exoscale.api.request.duration",
+		metric.WithDescription("Duration of Exoscale API operations, retries included."),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiMetrics{requests: requests, duration: duration}, nil
+}
+
+// startOperationSpan starts the span of an API operation, if tracing is enabled.
+func (c Client) startOperationSpan(ctx context.Context, operationID string, req *http.Request) (context.Context, trace.Span) {
+	if c.tracer == nil {
+		return ctx, nil
+	}
+
+	attrs := append(operationAttributes(operationID, req), attrServer.String(req.URL.Hostname()))
+	if id := resourceID(req); id != "" {
+		attrs = append(attrs, attrResourceID.String(id))
+	}
+
+	return c.tracer.Start(ctx, operationID, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+}
+
+// endOperationSpan ends the span of an API operation given its outcome.
+func endOperationSpan(span trace.Span, resp *http.Response, err error, retries int32) {
+	if span == nil {
+		return
+	}
+	defer span.End()
+
+	span.SetAttributes(attrRetries.Int(int(retries)))
+	if resp != nil {
+		span.SetAttributes(attrStatusCode.Int(resp.StatusCode))
+	}
+
+	if errType := errorType(resp, err); errType != "" {
+		span.SetAttributes(attrErrorType.String(errType))
+		span.SetStatus(codes.Error, errType)
+	}
+	if err != nil {
+		span.RecordError(err)
+	}
+}
+
+// recordOperationMetrics records the metrics of an API operation, if metrics are enabled.
+func (c Client) recordOperationMetrics(
+	ctx context.Context,
+	operationID string,
+	req *http.Request,
+	resp *http.Response,
+	err error,
+	latency time.Duration,
+) {
+	if c.metrics == nil {
+		return
+	}
+
+	attrs := operationAttributes(operationID, req)
+	if resp != nil {
+		attrs = append(attrs, attrStatusCode.Int(resp.StatusCode))
+	}
+	if errType := errorType(resp, err); errType != "" {
+		attrs = append(attrs, attrErrorType.String(errType))
+	}
+
+	set := metric.WithAttributes(attrs...)
+	c.metrics.requests.Add(ctx, 1, set)
+	c.metrics.duration.Record(ctx, latency.Seconds(), set)
+}
+
+// traceWait starts the span of a Waiter call waiting for the given operations,
+// and returns a function ending it given the operations done.
+func (c Client) traceWait(ctx context.Context, name string, ops []*Operation) (context.Context, func([]*Operation, error)) {
+	if c.tracer == nil {
+		return ctx, func([]*Operation, error) {}
+	}
+
+	ctx, span := c.tracer.Start(ctx, name, trace.WithAttributes(waitAttributes(attrOpID, ops, func(op *Operation) string {
+		return op.ID.String()
+	})...))
+
+	return ctx, func(done []*Operation, err error) {
+		defer span.End()
+
+		span.SetAttributes(waitAttributes(attrOpState, done, func(op *Operation) string {
+			return string(op.State)
+		})...)
+		span.SetAttributes(waitAttributes(attrResourceID, done, func(op *Operation) string {
+			if op.Reference == nil {
+				return ""
+			}
+			return op.Reference.ID.String()
+		})...)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+	}
+}
+
+// waitAttributes returns the attribute of the given operations set to their values,
+// a single value for a single operation. Nil operations and empty values are skipped.
+func waitAttributes(key attribute.Key, ops []*Operation, value func(*Operation) string) []attribute.KeyValue {
+	var values []string
+	for _, op := range ops {
+		if op == nil {
+			continue
+		}
+		if v := value(op); v != "" {
+			values = append(values, v)
+		}
+	}
+
+	switch len(values) {
+	case 0:
+		return nil
+	case 1:
+		if len(ops) == 1 {
+			return []attribute.KeyValue{key.String(values[0])}
+		}
+	}
+
+	return []attribute.KeyValue{key.StringSlice(values)}
+}
+
+func operationAttributes(operationID string, req *http.Request) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{
+		attrOperation.String(operationID),
+		attrMethod.String(req.Method),
+	}
+	if zone := zoneFromHost(req.URL.Hostname()); zone != "" {
+		attrs = append(attrs, attrZone.String(zone))
+	}
+
+	return attrs
+}
+
+// zoneFromHost returns the zone name of an Exoscale zone API endpoint host
+// (e.g. "ch-gva-2" for "api-ch-gva-2.exoscale.com"), or an empty string.
+func zoneFromHost(host string) string {
+	label, domain, ok := strings.Cut(host, ".")
+	if !ok || domain != "exoscale.com" {
+		return ""
+	}
+
+	_, zone, ok := strings.Cut(label, "-")
+	if !ok {
+		return ""
+	}
+
+	return zone
+}
+
+// resourceID returns the ID of the resource targeted by a request,
+// i.e. the last UUID of the request path, or an empty string.
+func resourceID(req *http.Request) string {
+	segments := strings.Split(req.URL.Path, "/")
+	for i := len(segments) - 1; i >= 0; i-- {
+		if uuidPathSegment.MatchString(segments[i]) {
+			return segments[i]
+		}
+	}
+
+	return ""
+}
+
+// errorType returns the class of error of an API operation outcome,
+// or an empty string if it succeeded.
+func errorType(resp *http.Response, err error) string {
+	var netErr net.Error
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		return "canceled"
+	case errors.Is(err, context.DeadlineExceeded):
+		return "timeout"
+	case errors.As(err, &netErr) && netErr.Timeout():
+		return "timeout"
+	case err != nil:
+		return "transport"
+	case resp.StatusCode >= http.StatusBadRequest:
+		return strconv.Itoa(resp.StatusCode)
+	}
+
+	return ""
+}
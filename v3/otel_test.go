@@ -0,0 +1,231 @@
+package v3
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/exoscale/egoscale/v3/credentials"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestClientInstrumentation(t *testing.T) {
+	spans := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/instance/bb2dcf4a-3e1b-4ce9-8f3c-6a8b1d5f0a27" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"d3e8d1a6-7a3c-4d5e-9b1f-2d4c5e6f7a8b","state":"success"}`))
+	},
+		ClientOptWithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))),
+		ClientOptWithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))),
+	)
+
+	ctx := context.Background()
+	if _, err := client.Wait(ctx, &Operation{
+		ID:    UUID("d3e8d1a6-7a3c-4d5e-9b1f-2d4c5e6f7a8b"),
+		State: OperationStateSuccess,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GetOperation(ctx, UUID("d3e8d1a6-7a3c-4d5e-9b1f-2d4c5e6f7a8b")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GetInstance(ctx, UUID("bb2dcf4a-3e1b-4ce9-8f3c-6a8b1d5f0a27")); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected %v, got %v", ErrNotFound, err)
+	}
+
+	ended := spans.Ended()
+	if len(ended) != 3 {
+		t.Fatalf("expected 3 spans, got %d", len(ended))
+	}
+
+	for i, want := range []struct {
+		name       string
+		status     codes.Code
+		attributes []attribute.KeyValue
+	}{
+		{
+			name: "wait-operation",
+			attributes: []attribute.KeyValue{
+				attrOpID.String("d3e8d1a6-7a3c-4d5e-9b1f-2d4c5e6f7a8b"),
+				attrOpState.String(string(OperationStateSuccess)),
+			},
+		},
+		{
+			name: "get-operation",
+			attributes: []attribute.KeyValue{
+				attrResourceID.String("d3e8d1a6-7a3c-4d5e-9b1f-2d4c5e6f7a8b"),
+				attrStatusCode.Int(http.StatusOK),
+			},
+		},
+		{
+			name:   "get-instance",
+			status: codes.Error,
+			attributes: []attribute.KeyValue{
+				attrResourceID.String("bb2dcf4a-3e1b-4ce9-8f3c-6a8b1d5f0a27"),
+				attrStatusCode.Int(http.StatusNotFound),
+				attrErrorType.String("404"),
+			},
+		},
+	} {
+		span := ended[i]
+		if span.Name() != want.name {
+			t.Errorf("expected span %q, got %q", want.name, span.Name())
+		}
+		if span.Status().Code != want.status {
+			t.Errorf("span %q: expected status %v, got %v", want.name, want.status, span.Status().Code)
+		}
+
+		attrs := attribute.NewSet(span.Attributes()...)
+		for _, kv := range want.attributes {
+			if v, ok := attrs.Value(kv.Key); !ok || v != kv.Value {
+				t.Errorf("span %q: expected attribute %s=%v, got %v", want.name, kv.Key, kv.Value.Emit(), v.Emit())
+			}
+		}
+	}
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(ctx, &rm); err != nil {
+		t.Fatal(err)
+	}
+
+	requests := map[string]int64{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name != "exoscale.api.requests" {
+				continue
+			}
+			for _, dp := range m.Data.(metricdata.Sum[int64]).DataPoints {
+				op, _ := dp.Attributes.Value(attrOperation)
+				requests[op.AsString()] += dp.Value
+			}
+		}
+	}
+	if requests["get-operation"] != 1 || requests["get-instance"] != 1 {
+		t.Errorf("unexpected request counts: %v", requests)
+	}
+}
+
+func TestClientInstrumentationCredentialsRefresh(t *testing.T) {
+	spans := tracetest.NewSpanRecorder()
+
+	client, requests := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if !strings.Contains(r.Header.Get("Authorization"), "credential=EXOnew,") {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"bb2dcf4a-3e1b-4ce9-8f3c-6a8b1d5f0a27"}`))
+	}, ClientOptWithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))))
+
+	provider := &rotatingProvider{key: "EXOold"}
+	client.credentials = credentials.NewCredentials(provider)
+	if _, err := client.credentials.Get(); err != nil {
+		t.Fatal(err)
+	}
+	provider.rotate("EXOnew", false)
+
+	if _, err := client.GetInstance(context.Background(), UUID("bb2dcf4a-3e1b-4ce9-8f3c-6a8b1d5f0a27")); err != nil {
+		t.Fatal(err)
+	}
+
+	// The request sent again with refreshed credentials belongs to the operation span.
+	ended := spans.Ended()
+	if got := atomic.LoadInt32(requests); got != 2 || len(ended) != 1 {
+		t.Fatalf("expected 2 requests in 1 span, got %d requests in %d spans", got, len(ended))
+	}
+	attrs := attribute.NewSet(ended[0].Attributes()...)
+	if v, _ := attrs.Value(attrStatusCode); v.AsInt64() != http.StatusOK {
+		t.Errorf("expected span status code %d, got %v", http.StatusOK, v.Emit())
+	}
+}
+
+func TestWaiterInstrumentation(t *testing.T) {
+	const (
+		succeeded = UUID("d3e8d1a6-7a3c-4d5e-9b1f-2d4c5e6f7a01")
+		failed    = UUID("d3e8d1a6-7a3c-4d5e-9b1f-2d4c5e6f7a02")
+	)
+	client, _ := newTestOperationsClient(t, map[UUID]OperationState{
+		succeeded: OperationStateSuccess,
+		failed:    OperationStateFailure,
+	}, 1)
+	spans := tracetest.NewSpanRecorder()
+	if err := ClientOptWithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans)))(client); err != nil {
+		t.Fatal(err)
+	}
+
+	waiter := client.NewWaiter(
+		WaiterOptWithStates(OperationStateSuccess),
+		WaiterOptWithPollStrategy(ConstantPollStrategy(time.Millisecond)),
+	)
+	ops := []*Operation{
+		{ID: succeeded, State: OperationStatePending},
+		{ID: failed, State: OperationStatePending},
+	}
+	ctx := context.Background()
+	if _, err := waiter.WaitAll(ctx, ops...); err == nil {
+		t.Fatal("expected an error for the failed operation")
+	}
+	if _, err := waiter.WaitAny(ctx, ops...); err != nil {
+		t.Fatal(err)
+	}
+
+	var ended []sdktrace.ReadOnlySpan
+	for _, span := range spans.Ended() {
+		if strings.HasPrefix(span.Name(), "wait-") {
+			ended = append(ended, span)
+		}
+	}
+	if len(ended) != 2 {
+		t.Fatalf("expected 2 wait spans, got %d", len(ended))
+	}
+	for i, want := range []struct {
+		name   string
+		status codes.Code
+		states []string
+	}{
+		{name: "wait-all-operations", status: codes.Error, states: []string{"success", "failure"}},
+		{name: "wait-any-operation", states: []string{"success"}},
+	} {
+		span := ended[i]
+		if span.Name() != want.name || span.Status().Code != want.status {
+			t.Errorf("expected span %q with status %v, got %q with status %v", want.name, want.status, span.Name(), span.Status().Code)
+		}
+
+		attrs := attribute.NewSet(span.Attributes()...)
+		if v, _ := attrs.Value(attrOpID); !reflect.DeepEqual(v.AsStringSlice(), []string{string(succeeded), string(failed)}) {
+			t.Errorf("span %q: unexpected operation IDs %v", want.name, v.Emit())
+		}
+		if v, _ := attrs.Value(attrOpState); !reflect.DeepEqual(v.AsStringSlice(), want.states) {
+			t.Errorf("span %q: expected operation states %v, got %v", want.name, want.states, v.Emit())
+		}
+	}
+}
+
+func TestZoneFromHost(t *testing.T) {
+	for host, zone := range map[string]string{
+		"api-ch-gva-2.exoscale.com":   "ch-gva-2",
+		"ppapi-de-fra-1.exoscale.com": "de-fra-1",
+		"api.exoscale.com":            "",
+		"127.0.0.1":                   "",
+	} {
+		if got := zoneFromHost(host); got != zone {
+			t.Errorf("zoneFromHost(%q) = %q, expected %q", host, got, zone)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package v3
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/exoscale/egoscale/v3/credentials"
+)
+
+// defaultEnvironment is the default API environment of Exoscale CLI accounts.
+const defaultEnvironment = "api"
+
+// ClientOptsFromAccount returns the ClientOpts configuring a client for an account
+// of the Exoscale CLI configuration file the same way the CLI does:
+//   - the endpoint is the one of the account default zone (ch-gva-2 if unset)
+//     in the account environment (e.g. "ppapi", "api" if unset)
+//   - the wait timeout is the account client timeout, in minutes
+//   - the account custom headers are added to every request
+func ClientOptsFromAccount(account credentials.Account) []ClientOpt {
+	environment := account.Environment
+	if environment == "" {
+		environment = defaultEnvironment
+	}
+	zone := account.DefaultZone
+	if zone == "" {
+		zone = string(ZoneNameCHGva2)
+	}
+
+	opts := []ClientOpt{
+		ClientOptWithEndpoint(Endpoint(fmt.Sprintf("https://%s-%s.exoscale.com/v2", environment, zone))),
+	}
+
+	if account.ClientTimeout > 0 {
+		opts = append(opts, ClientOptWithWaitTimeout(time.Duration(account.ClientTimeout)*time.Minute))
+	}
+
+	if len(account.CustomHeaders) > 0 {
+		headers := make(http.Header, len(account.CustomHeaders))
+		for k, v := range account.CustomHeaders {
+			headers.Set(k, v)
+		}
+		opts = append(opts, ClientOptWithRequestInterceptors(func(ctx context.Context, req *http.Request) error {
+			for k, v := range headers {
+				req.Header[k] = v
+			}
+			return nil
+		}))
+	}
+
+	return opts
+}
+
+// NewClientFromProfile returns a new Exoscale API client using the credentials
+// of the file provider account, configured with ClientOptsFromAccount.
+// Options given as argument are applied last, overriding the account configuration.
+func NewClientFromProfile(provider *credentials.FileProvider, opts ...ClientOpt) (*Client, error) {
+	account, err := provider.Account()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewClient(
+		credentials.NewCredentials(provider),
+		append(ClientOptsFromAccount(account), opts...)...,
+	)
+}
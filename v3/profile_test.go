@@ -0,0 +1,124 @@
+package v3
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/exoscale/egoscale/v3/credentials"
+)
+
+func writeTestConfig(t *testing.T, config string) string {
+	t.Helper()
+
+	filename := filepath.Join(t.TempDir(), "exoscale.toml")
+	if err := os.WriteFile(filename, []byte(config), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return filename
+}
+
+func TestNewClientFromProfile(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Custom") != "test" ||
+			!strings.Contains(r.Header.Get("Authorization"), "credential=EXOtest,") {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"bb2dcf4a-3e1b-4ce9-8f3c-6a8b1d5f0a27"}`))
+	}))
+	defer ts.Close()
+
+	filename := writeTestConfig(t, `
+defaultAccount = "test"
+
+[[accounts]]
+name = "test"
+key = "EXOtest"
+secretCommand = ["sh", "-c", "echo secret"]
+defaultZone = "de-fra-1"
+environment = "ppapi"
+clientTimeout = 5
+
+[accounts.customHeaders]
+X-Custom = "test"
+`)
+
+	provider := credentials.NewFileProvider(credentials.FileOptWithFilename(filename))
+	client, err := NewClientFromProfile(provider)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if client.serverEndpoint != "https://ppapi-de-fra-1.exoscale.com/v2" {
+		t.Errorf("unexpected endpoint %q", client.serverEndpoint)
+	}
+	if client.waitTimeout != 5*time.Minute {
+		t.Errorf("unexpected wait timeout %v", client.waitTimeout)
+	}
+
+	creds, err := client.credentials.Get()
+	if err != nil || creds.APISecret != "secret" {
+		t.Errorf("expected secret from the secret command, got %q (error: %v)", creds.APISecret, err)
+	}
+
+	if _, err := client.WithEndpoint(Endpoint(ts.URL)).GetInstance(context.Background(), UUID("bb2dcf4a-3e1b-4ce9-8f3c-6a8b1d5f0a27")); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestFileProviderSecretCommand(t *testing.T) {
+	for _, test := range []struct {
+		name    string
+		command string
+		wantErr string
+	}{
+		{
+			name:    "failure",
+			command: `["sh", "-c", "echo 'no such secret' >&2; exit 1"]`,
+			wantErr: "exit status 1: no such secret",
+		},
+		{
+			name:    "timeout",
+			command: `["sleep", "1"]`,
+			wantErr: "context deadline exceeded after 10ms",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			filename := writeTestConfig(t, `
+defaultAccount = "test"
+
+[[accounts]]
+name = "test"
+key = "EXOtest"
+secretCommand = `+test.command+`
+`)
+
+			_, err := credentials.NewFileProvider(
+				credentials.FileOptWithFilename(filename),
+				credentials.FileOptWithSecretCommandTimeout(10*time.Millisecond),
+			).Retrieve()
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Errorf("expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+
+	filename := writeTestConfig(t, `
+defaultAccount = "other"
+
+[[accounts]]
+name = "test"
+key = "EXOtest"
+`)
+	if _, err := credentials.NewFileProvider(credentials.FileOptWithFilename(filename)).Retrieve(); !errors.Is(err, credentials.ErrMissingIncomplete) {
+		t.Errorf("expected %v, got %v", credentials.ErrMissingIncomplete, err)
+	}
+}
@@ -0,0 +1,204 @@
+package v3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a client-side token bucket rate limiter for Exoscale API requests.
+// A RateLimiter is safe for concurrent use: sharing a single RateLimiter between
+// several clients (or clients cloned with the WithX methods, which share it
+// automatically) coordinates their request rate.
+type RateLimiter struct {
+	global *tokenBucket
+
+	endpointRate  float64
+	endpointBurst int
+
+	mu        sync.Mutex
+	endpoints map[string]*tokenBucket
+}
+
+// RateLimiterOpt represents a function setting a RateLimiter option.
+type RateLimiterOpt func(*RateLimiter)
+
+// RateLimiterOptWithEndpointLimit returns a RateLimiterOpt additionally limiting
+// the request rate of every zone API endpoint to rate requests per second, with
+// bursts of up to burst requests. A rate of 0 or less (or +Inf) disables the
+// endpoint limit.
+func RateLimiterOptWithEndpointLimit(rate float64, burst int) RateLimiterOpt {
+	return func(l *RateLimiter) {
+		l.endpointRate = rate
+		l.endpointBurst = burst
+	}
+}
+
+// NewRateLimiter returns a RateLimiter allowing rate requests per second
+// across all zone API endpoints, with bursts of up to burst requests.
+// A rate of 0 or less (or +Inf) does not limit requests: such a RateLimiter
+// only applies its endpoint limit, if any.
+func NewRateLimiter(rate float64, burst int, opts ...RateLimiterOpt) *RateLimiter {
+	l := &RateLimiter{
+		global:    newTokenBucket(rate, burst),
+		endpoints: make(map[string]*tokenBucket),
+	}
+
+	for _, opt := range opts {
+		opt(l)
+	}
+
+	return l
+}
+
+// errRateLimiterWait is wrapped by the errors of requests given up while waiting for the rate limiter.
+var errRateLimiterWait = errors.New("rate limiter")
+
+// Wait blocks until a request to the given zone API endpoint host is allowed,
+// or until the context is done.
+func (l *RateLimiter) Wait(ctx context.Context, host string) error {
+	buckets := []*tokenBucket{l.global}
+	if b := l.endpointBucket(host); b != nil {
+		buckets = append(buckets, b)
+	}
+
+	now := time.Now()
+	var wait time.Duration
+	for _, b := range buckets {
+		wait = max(wait, b.reserve(now))
+	}
+
+	cancel := func() {
+		for _, b := range buckets {
+			b.cancel()
+		}
+	}
+
+	if wait == 0 {
+		return nil
+	}
+
+	if deadline, ok := ctx.Deadline(); ok && deadline.Before(now.Add(wait)) {
+		cancel()
+		return fmt.Errorf("%w: wait of %s exceeds context deadline: %w", errRateLimiterWait, wait, context.DeadlineExceeded)
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		cancel()
+		return fmt.Errorf("%w: %w", errRateLimiterWait, ctx.Err())
+	}
+}
+
+func (l *RateLimiter) endpointBucket(host string) *tokenBucket {
+	if l.endpointRate <= 0 {
+		return nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b, ok := l.endpoints[host]
+	if !ok {
+		b = newTokenBucket(l.endpointRate, l.endpointBurst)
+		l.endpoints[host] = b
+	}
+
+	return b
+}
+
+// ClientOptWithRateLimiter returns a ClientOpt limiting the client request rate
+// with the given RateLimiter. Retried requests are rate limited as well.
+// A RateLimiter created with a rate of 0 or less does not limit requests,
+// see NewRateLimiter.
+func ClientOptWithRateLimiter(l *RateLimiter) ClientOpt {
+	return func(c *Client) error {
+		c.rateLimiter = l
+		return nil
+	}
+}
+
+// tokenBucket implements the token bucket algorithm.
+// Tokens are reserved in advance: the bucket level goes negative when
+// requests are queued, which keeps waiters ordered without a queue.
+type tokenBucket struct {
+	rate  float64
+	burst float64
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &tokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// reserve takes a token from the bucket and returns how long the caller
+// must wait before using it.
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	if b.rate <= 0 || math.IsInf(b.rate, 1) {
+		return 0
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if now.After(b.last) {
+		b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+		b.last = now
+	}
+
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// cancel gives back a token reserved but not used.
+func (b *tokenBucket) cancel() {
+	if b.rate <= 0 || math.IsInf(b.rate, 1) {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.tokens = math.Min(b.burst, b.tokens+1)
+}
+
+// rateLimitTransport is an http.RoundTripper waiting for the RateLimiter before sending requests.
+type rateLimitTransport struct {
+	limiter *RateLimiter
+	next    http.RoundTripper
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.Wait(req.Context(), req.URL.Host); err != nil {
+		return nil, err
+	}
+	attemptStarted(req)
+
+	return t.next.RoundTrip(req)
+}
@@ -0,0 +1,85 @@
+package v3
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterWait(t *testing.T) {
+	l := NewRateLimiter(20, 1)
+	ctx := context.Background()
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		if err := l.Wait(ctx, "api-ch-gva-2.exoscale.com"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The first request consumes the burst, the 4 others wait 50ms each.
+	if elapsed := time.Since(start); elapsed < 180*time.Millisecond {
+		t.Errorf("expected requests to be rate limited, 5 requests took %v", elapsed)
+	}
+}
+
+func TestRateLimiterWaitEndpoint(t *testing.T) {
+	l := NewRateLimiter(1000, 1000, RateLimiterOptWithEndpointLimit(1, 1))
+	ctx := context.Background()
+
+	if err := l.Wait(ctx, "api-ch-gva-2.exoscale.com"); err != nil {
+		t.Fatal(err)
+	}
+	// Other endpoints are not affected by the first endpoint limit.
+	if err := l.Wait(ctx, "api-de-fra-1.exoscale.com"); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+
+	if err := l.Wait(ctx, "api-ch-gva-2.exoscale.com"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected %v, got %v", context.DeadlineExceeded, err)
+	}
+}
+
+func TestRateLimiterSharedByClones(t *testing.T) {
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"zones":[]}`))
+	}, ClientOptWithRateLimiter(NewRateLimiter(20, 1)))
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			clone := client.WithUserAgent("test")
+			if _, err := clone.ListZones(context.Background()); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if elapsed := time.Since(start); elapsed < 180*time.Millisecond {
+		t.Errorf("expected clones to share the rate limiter, 5 requests took %v", elapsed)
+	}
+}
+
+func TestRateLimiterUnlimited(t *testing.T) {
+	l := NewRateLimiter(0, 0)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	// A rate of 0 does not limit requests.
+	for i := 0; i < 100; i++ {
+		if err := l.Wait(ctx, "api-ch-gva-2.exoscale.com"); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
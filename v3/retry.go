@@ -0,0 +1,299 @@
+package v3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// RetryPolicy represents the retry behavior of the Exoscale API client.
+// Zero-valued fields are set to the default retry configuration.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts for a request, including the first one.
+	// Setting it to 1 disables retries.
+	MaxAttempts int
+	// MinBackoff is the wait time before the first retry.
+	MinBackoff time.Duration
+	// MaxBackoff is the upper bound of the wait time between two attempts.
+	MaxBackoff time.Duration
+	// Multiplier is the growth factor of the wait time between two attempts.
+	Multiplier float64
+	// Jitter is the fraction (between 0 and 1) of the wait time randomly removed
+	// from each backoff, to avoid retrying clients hitting the API in lockstep.
+	Jitter float64
+	// RetryNonIdempotent enables retrying non-idempotent requests (e.g. POST)
+	// on server and transport errors, which may lead to duplicate resources.
+	// Non-idempotent requests rejected with HTTP 429 are always retried.
+	RetryNonIdempotent bool
+}
+
+// defaultRetryPolicy matches the go-retryablehttp defaults used by defaultHTTPClient.
+var defaultRetryPolicy = RetryPolicy{
+	MaxAttempts:        5,
+	MinBackoff:         time.Second,
+	MaxBackoff:         30 * time.Second,
+	Multiplier:         2,
+	RetryNonIdempotent: true,
+}
+
+// ClientOptWithRetryPolicy returns a ClientOpt setting the retry policy of the HTTP client.
+// The policy is applied on top of the http.Client set with ClientOptWithHTTPClient, if any.
+func ClientOptWithRetryPolicy(p RetryPolicy) ClientOpt {
+	return func(c *Client) error {
+		if p.Jitter < 0 || p.Jitter > 1 {
+			return fmt.Errorf("invalid retry policy jitter %v: must be between 0 and 1", p.Jitter)
+		}
+		if p.MaxAttempts < 0 {
+			return fmt.Errorf("invalid retry policy max attempts %d", p.MaxAttempts)
+		}
+
+		p = p.withDefaults()
+		c.retryPolicy = &p
+		return nil
+	}
+}
+
+func (p RetryPolicy) withDefaults() RetryPolicy {
+	if p.MaxAttempts == 0 {
+		p.MaxAttempts = defaultRetryPolicy.MaxAttempts
+	}
+	if p.MinBackoff == 0 {
+		p.MinBackoff = defaultRetryPolicy.MinBackoff
+	}
+	if p.MaxBackoff == 0 {
+		p.MaxBackoff = defaultRetryPolicy.MaxBackoff
+	}
+	if p.MaxBackoff < p.MinBackoff {
+		p.MaxBackoff = p.MinBackoff
+	}
+	if p.Multiplier < 1 {
+		p.Multiplier = defaultRetryPolicy.Multiplier
+	}
+
+	return p
+}
+
+// backoff returns the wait time before the next attempt.
+// The Retry-After header of HTTP 429 and 503 responses takes precedence over the policy.
+func (p RetryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil &&
+		(resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable) {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return wait
+		}
+	}
+
+	wait := float64(p.MinBackoff) * math.Pow(p.Multiplier, float64(attempt))
+	if wait > float64(p.MaxBackoff) {
+		wait = float64(p.MaxBackoff)
+	}
+	if p.Jitter > 0 {
+		wait -= wait * p.Jitter * rand.Float64()
+	}
+
+	return time.Duration(wait)
+}
+
+// checkRetry reports whether a request must be retried given its outcome.
+func (p RetryPolicy) checkRetry(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	if ctx.Err() != nil {
+		return false, ctx.Err()
+	}
+
+	// The API rejected the request without processing it, it's always safe to send it again.
+	if err == nil && resp.StatusCode == http.StatusTooManyRequests {
+		return true, nil
+	}
+
+	if !p.RetryNonIdempotent && !isIdempotentRequest(ctx) {
+		return false, nil
+	}
+
+	retry, _ := retryablehttp.DefaultRetryPolicy(ctx, resp, err)
+	return retry, nil
+}
+
+// parseRetryAfter parses a Retry-After header value, expressed either
+// in seconds or as an HTTP date.
+func parseRetryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.ParseInt(v, 10, 64); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	date, err := http.ParseTime(v)
+	if err != nil {
+		return 0, false
+	}
+	if wait := time.Until(date); wait > 0 {
+		return wait, true
+	}
+
+	return 0, true
+}
+
+type idempotentRequestKey struct{}
+
+func isIdempotentRequest(ctx context.Context) bool {
+	idempotent, _ := ctx.Value(idempotentRequestKey{}).(bool)
+	return idempotent
+}
+
+// retryTransport is an http.RoundTripper retrying requests according to a RetryPolicy.
+type retryTransport struct {
+	client *retryablehttp.Client
+}
+
+func newRetryTransport(base *http.Client, p RetryPolicy) *retryTransport {
+	rc := retryablehttp.NewClient()
+	// silence client by default
+	rc.Logger = log.New(io.Discard, "", 0)
+	rc.HTTPClient = base
+	rc.RequestLogHook = recordRetry
+	rc.RetryMax = p.MaxAttempts - 1
+	rc.RetryWaitMin = p.MinBackoff
+	rc.RetryWaitMax = p.MaxBackoff
+	rc.CheckRetry = p.checkRetry
+	rc.Backoff = func(_, _ time.Duration, attempt int, resp *http.Response) time.Duration {
+		return p.backoff(attempt, resp)
+	}
+	// Return the last response once retries are exhausted,
+	// so that API errors are reported as such to the caller.
+	rc.ErrorHandler = func(resp *http.Response, err error, _ int) (*http.Response, error) {
+		if resp != nil {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	return &retryTransport{client: rc}
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	idempotent := req.Method == http.MethodGet ||
+		req.Method == http.MethodHead ||
+		req.Method == http.MethodOptions ||
+		req.Method == http.MethodPut ||
+		req.Method == http.MethodDelete
+
+	rreq, err := retryablehttp.FromRequest(
+		req.WithContext(context.WithValue(req.Context(), idempotentRequestKey{}, idempotent)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.client.Do(rreq)
+	// Unwrap errors returned by the underlying http.Client
+	// to avoid nesting them when the caller's http.Client wraps them again.
+	if _, ok := err.(*url.Error); ok {
+		return resp, errors.Unwrap(err)
+	}
+
+	return resp, err
+}
+
+// wrapHTTPClient returns an http.Client applying the Client retry policy
+// and rate limiter on top of hc.
+// When neither is configured, hc is returned unchanged.
+func (c *Client) wrapHTTPClient(hc *http.Client) *http.Client {
+	if c.retryPolicy == nil && c.rateLimiter == nil {
+		return hc
+	}
+
+	policy := c.retryPolicy
+	// defaultHTTPClient already retries requests: rebuild it from a bare
+	// HTTP client, so that rate limiting applies to every attempt.
+	if hc == defaultHTTPClient {
+		hc = cleanhttp.DefaultPooledClient()
+		if policy == nil {
+			policy = &defaultRetryPolicy
+		}
+	}
+
+	if c.rateLimiter != nil {
+		limited := *hc
+		next := limited.Transport
+		if next == nil {
+			next = http.DefaultTransport
+		}
+		limited.Transport = &rateLimitTransport{limiter: c.rateLimiter, next: next}
+		hc = &limited
+	}
+
+	if policy == nil {
+		return hc
+	}
+
+	return &http.Client{Transport: newRetryTransport(hc, *policy)}
+}
+
+type requestAttemptsKey struct{}
+
+// requestAttempts tracks the attempts of sending a request, which may be retried
+// or delayed by the rate limiter.
+type requestAttempts struct {
+	now     func() time.Time
+	retries atomic.Int32
+
+	mu   sync.Mutex
+	sent time.Time
+}
+
+// withRequestAttempts returns a context carrying a tracker of the attempts of sending a request,
+// getting the time from now.
+func withRequestAttempts(ctx context.Context, now func() time.Time) (context.Context, *requestAttempts) {
+	attempts := &requestAttempts{now: now, sent: now()}
+	return context.WithValue(ctx, requestAttemptsKey{}, attempts), attempts
+}
+
+// started records that an attempt is being sent, once retry backoffs and rate limiter waits are over.
+func (a *requestAttempts) started() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.sent = a.now()
+}
+
+// lastSent returns the time the last attempt was sent.
+func (a *requestAttempts) lastSent() time.Time {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.sent
+}
+
+// attemptStarted records that an attempt of the request is being sent.
+func attemptStarted(req *http.Request) {
+	if attempts, ok := req.Context().Value(requestAttemptsKey{}).(*requestAttempts); ok {
+		attempts.started()
+	}
+}
+
+// recordRetry is a retryablehttp.RequestLogHook recording the attempts of a request.
+func recordRetry(_ retryablehttp.Logger, req *http.Request, attempt int) {
+	if attempts, ok := req.Context().Value(requestAttemptsKey{}).(*requestAttempts); ok {
+		attempts.retries.Store(int32(attempt))
+		attempts.started()
+	}
+}
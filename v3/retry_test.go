@@ -0,0 +1,125 @@
+package v3
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicyRetryAfter(t *testing.T) {
+	var served int32
+	client, attempts := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&served, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"d3e8d1a6-7a3c-4d5e-9b1f-2d4c5e6f7a8b","state":"success"}`))
+	}, ClientOptWithRetryPolicy(RetryPolicy{MinBackoff: time.Hour, MaxBackoff: time.Hour}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	op, err := client.GetOperation(ctx, UUID("d3e8d1a6-7a3c-4d5e-9b1f-2d4c5e6f7a8b"))
+	if err != nil {
+		t.Fatalf("GetOperation: %v", err)
+	}
+	if op.State != OperationStateSuccess {
+		t.Errorf("expected state %q, got %q", OperationStateSuccess, op.State)
+	}
+	if got := atomic.LoadInt32(attempts); got != 2 {
+		t.Errorf("expected 2 attempts, got %d", got)
+	}
+}
+
+func TestRetryPolicyNonIdempotent(t *testing.T) {
+	tests := []struct {
+		name               string
+		status             int
+		retryNonIdempotent bool
+		wantAttempts       int32
+		wantErr            error
+	}{
+		{
+			name:         "server error not retried",
+			status:       http.StatusInternalServerError,
+			wantAttempts: 1,
+			wantErr:      ErrInternalServerError,
+		},
+		{
+			name:               "server error retried on opt-in",
+			status:             http.StatusInternalServerError,
+			retryNonIdempotent: true,
+			wantAttempts:       3,
+			wantErr:            ErrInternalServerError,
+		},
+		{
+			name:         "too many requests always retried",
+			status:       http.StatusTooManyRequests,
+			wantAttempts: 3,
+			wantErr:      ErrTooManyRequests,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, attempts := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+			}, ClientOptWithRetryPolicy(RetryPolicy{
+				MaxAttempts:        3,
+				MinBackoff:         time.Millisecond,
+				MaxBackoff:         time.Millisecond,
+				RetryNonIdempotent: tt.retryNonIdempotent,
+			}))
+
+			_, err := client.CreatePrivateNetwork(context.Background(), CreatePrivateNetworkRequest{Name: "test"})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected error %v, got %v", tt.wantErr, err)
+			}
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Errorf("expected an *APIError, got %T", err)
+			}
+
+			if got := atomic.LoadInt32(attempts); got != tt.wantAttempts {
+				t.Errorf("expected %d attempts, got %d", tt.wantAttempts, got)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := RetryPolicy{
+		MinBackoff: time.Second,
+		MaxBackoff: 10 * time.Second,
+		Multiplier: 2,
+		Jitter:     0.5,
+	}.withDefaults()
+
+	for attempt, max := range []time.Duration{
+		time.Second,
+		2 * time.Second,
+		4 * time.Second,
+		8 * time.Second,
+		10 * time.Second,
+		10 * time.Second,
+	} {
+		wait := p.backoff(attempt, nil)
+		if wait > max || wait < max/2 {
+			t.Errorf("backoff(%d) = %v, expected between %v and %v", attempt, wait, max/2, max)
+		}
+	}
+
+	resp := &http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{"Retry-After": []string{"42"}},
+	}
+	if wait := p.backoff(0, resp); wait != 42*time.Second {
+		t.Errorf("expected Retry-After to be honoured, got %v", wait)
+	}
+}
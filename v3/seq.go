@@ -0,0 +1,194 @@
+package v3
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"time"
+)
+
+// The generated ListXSeq methods return iterators over the elements listed by the ListX operations,
+// decoding them while the response body is read rather than decoding the whole response first.
+// Their calls go through the middleware chain like other operations, with Call.Stream set.
+
+// listDecoder decodes the elements of a JSON array, at the top level of the response body
+// or in the given field of the top level object, calling yield for each.
+type listDecoder[T any] struct {
+	ctx   context.Context
+	field string
+	yield func(T, error) bool
+
+	// streamed is set once the response body is decoded, stopped once yield returned false.
+	streamed bool
+	stopped  bool
+}
+
+func (d *listDecoder[T]) decode(dec *json.Decoder) error {
+	d.streamed = true
+
+	if d.field == "" {
+		return d.decodeArray(dec)
+	}
+
+	if err := expectDelim(dec, '{'); err != nil {
+		return err
+	}
+	for dec.More() {
+		key, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		if key != d.field {
+			var skip json.RawMessage
+			if err := dec.Decode(&skip); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := d.decodeArray(dec); err != nil || d.stopped {
+			return err
+		}
+	}
+
+	return expectDelim(dec, '}')
+}
+
+func (d *listDecoder[T]) decodeArray(dec *json.Decoder) error {
+	// The field may be null if there is no element.
+	if !dec.More() {
+		return nil
+	}
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok == nil {
+		return nil
+	}
+	if tok != json.Delim('[') {
+		return fmt.Errorf("unexpected JSON token %v, expected [", tok)
+	}
+
+	for dec.More() {
+		if err := d.ctx.Err(); err != nil {
+			return err
+		}
+
+		var v T
+		if err := dec.Decode(&v); err != nil {
+			return err
+		}
+		if !d.yield(v, nil) {
+			d.stopped = true
+			return nil
+		}
+	}
+
+	return expectDelim(dec, ']')
+}
+
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok != delim {
+		return fmt.Errorf("unexpected JSON token %v, expected %v", tok, delim)
+	}
+
+	return nil
+}
+
+// streamList executes the call of a list operation, yielding the listed elements while
+// the response body is decoded: the array at the top level of the body, or in the given field.
+// If a middleware served the call result without the response body being streamed,
+// the elements returned by elems are yielded instead.
+// Stopping the iteration early is not an error.
+func streamList[T any](
+	ctx context.Context,
+	c Client,
+	call *Call,
+	sign bool,
+	field string,
+	elems func() []T,
+	yield func(T, error) bool,
+) error {
+	d := &listDecoder[T]{ctx: ctx, field: field, yield: yield}
+	call.Stream = d.decode
+
+	err := c.handle(ctx, call, sign)
+	if d.stopped {
+		return nil
+	}
+	if err != nil || d.streamed {
+		return err
+	}
+
+	for _, v := range elems() {
+		if !yield(v, nil) {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// yieldError yields err with a zero element.
+func yieldError[T any](yield func(T, error) bool, err error) {
+	var zero T
+	yield(zero, err)
+}
+
+// windowSeq returns an iterator over the elements listed in the time range [from, to] by windows
+// of the given duration, in a request each. list returns an iterator over the elements listed
+// between its bounds, which are rounded to the second: elements are filtered by their timestamp
+// so that each is yielded once. A zero or negative window lists the whole range at once.
+func windowSeq[T any](
+	ctx context.Context,
+	from, to time.Time,
+	window time.Duration,
+	timestamp func(T) time.Time,
+	list func(ctx context.Context, from, to time.Time) iter.Seq2[T, error],
+) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		if window <= 0 {
+			window = to.Sub(from)
+		}
+
+		for start := from; !start.After(to); start = start.Add(window) {
+			end := start.Add(window)
+			last := !end.Before(to)
+			if last {
+				end = to
+			}
+
+			// Request bounds are rounded to the second: widen the range, then filter.
+			reqEnd := end.Truncate(time.Second)
+			if reqEnd.Before(end) {
+				reqEnd = reqEnd.Add(time.Second)
+			}
+
+			for v, err := range list(ctx, start.Truncate(time.Second), reqEnd) {
+				if err != nil {
+					yieldError(yield, err)
+					return
+				}
+
+				ts := timestamp(v)
+				if ts.Before(start) || ts.After(end) || (!last && ts.Equal(end)) {
+					continue
+				}
+				if !yield(v, nil) {
+					return
+				}
+			}
+
+			if last {
+				return
+			}
+		}
+	}
+}
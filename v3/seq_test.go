@@ -0,0 +1,199 @@
+package v3
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestListSeq(t *testing.T) {
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"other":{"instances":[]},"instances":[{"name":"a"},{"name":"b"},{"name":"c"}]}`))
+	})
+	ctx := context.Background()
+
+	var names []string
+	for instance, err := range client.ListInstancesSeq(ctx) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, instance.Name)
+	}
+	if len(names) != 3 || names[0] != "a" || names[2] != "c" {
+		t.Fatalf("unexpected instances: %v", names)
+	}
+
+	// Stop early.
+	names = nil
+	for instance, err := range client.ListInstancesSeq(ctx) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, instance.Name)
+		break
+	}
+	if len(names) != 1 {
+		t.Fatalf("unexpected instances: %v", names)
+	}
+
+	// The client still decodes whole responses.
+	resp, err := client.ListInstances(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Instances) != 3 {
+		t.Fatalf("unexpected instances: %+v", resp.Instances)
+	}
+}
+
+func TestListSeqMiddlewares(t *testing.T) {
+	var calls []*Call
+	var errs []error
+	observe := func(next Handler) Handler {
+		return func(ctx context.Context, call *Call) error {
+			err := next(ctx, call)
+			calls = append(calls, call)
+			errs = append(errs, err)
+			return err
+		}
+	}
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"instances":[{"name":"a"},{"name":"b"}]}`))
+	}, ClientOptWithMiddlewares(observe))
+
+	for _, err := range client.ListInstancesSeq(context.Background()) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		break
+	}
+
+	if len(calls) != 1 {
+		t.Fatalf("expected 1 call, got %d", len(calls))
+	}
+	if errs[0] != nil {
+		t.Fatalf("expected no error when stopping early, got %v", errs[0])
+	}
+	if _, ok := calls[0].Result.(*ListInstancesResponse); !ok {
+		t.Fatalf("expected a *ListInstancesResponse result, got %T", calls[0].Result)
+	}
+	if calls[0].Stream == nil {
+		t.Fatal("expected a streamed call")
+	}
+
+	// A middleware serving the result without sending the request.
+	cached := client.WithMiddleware(func(next Handler) Handler {
+		return func(ctx context.Context, call *Call) error {
+			call.Result.(*ListInstancesResponse).Instances = []ListInstancesResponseInstances{{Name: "cached"}}
+			return nil
+		}
+	})
+
+	var names []string
+	for instance, err := range cached.ListInstancesSeq(context.Background()) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, instance.Name)
+	}
+	if len(names) != 1 || names[0] != "cached" {
+		t.Fatalf("unexpected instances: %v", names)
+	}
+}
+
+func TestListSeqErrors(t *testing.T) {
+	t.Run("HTTP error", func(t *testing.T) {
+		client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"not found"}`))
+		})
+
+		var errs []error
+		for _, err := range client.ListInstancesSeq(context.Background()) {
+			errs = append(errs, err)
+		}
+		if len(errs) != 1 || !errors.Is(errs[0], ErrNotFound) {
+			t.Fatalf("expected a single %v, got %v", ErrNotFound, errs)
+		}
+	})
+
+	t.Run("context canceled", func(t *testing.T) {
+		client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`[{"message":"a"},{"message":"b"},{"message":"c"}]`))
+		})
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var messages []string
+		var lastErr error
+		for event, err := range client.ListEventsSeq(ctx) {
+			if err != nil {
+				lastErr = err
+				continue
+			}
+			messages = append(messages, event.Message)
+			cancel()
+		}
+		if len(messages) != 1 || !errors.Is(lastErr, context.Canceled) {
+			t.Fatalf("expected 1 event and %v, got %v and %v", context.Canceled, messages, lastErr)
+		}
+	})
+}
+
+func TestListEventsWindowSeq(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(3 * time.Hour)
+
+	// An event every 30 minutes, including the window bounds.
+	var events []Event
+	for ts := from; !ts.After(to); ts = ts.Add(30 * time.Minute) {
+		events = append(events, Event{Message: ts.Format(time.RFC3339), Timestamp: ts})
+	}
+
+	var requests int
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		reqFrom, _ := time.Parse(time.RFC3339, r.URL.Query().Get("from"))
+		reqTo, _ := time.Parse(time.RFC3339, r.URL.Query().Get("to"))
+
+		// Bounds are inclusive.
+		listed := []Event{}
+		for _, e := range events {
+			if !e.Timestamp.Before(reqFrom) && !e.Timestamp.After(reqTo) {
+				listed = append(listed, e)
+			}
+		}
+		_ = json.NewEncoder(w).Encode(listed)
+	})
+
+	// The windows bounds must not be appended to the backing array of the caller options.
+	opts := make([]ListEventsOpt, 1, 3)
+	opts[0] = ListEventsWithFrom(from)
+
+	var listed []Event
+	for event, err := range client.ListEventsWindowSeq(context.Background(), from, to, time.Hour, opts...) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		listed = append(listed, event)
+	}
+
+	if opts[:3][1] != nil {
+		t.Fatal("caller options overwritten")
+	}
+	if requests != 3 {
+		t.Fatalf("expected 3 requests, got %d", requests)
+	}
+	if len(listed) != len(events) {
+		t.Fatalf("expected %d events, got %d", len(events), len(listed))
+	}
+	for i := range events {
+		if !listed[i].Timestamp.Equal(events[i].Timestamp) {
+			t.Fatalf("expected event at %v, got %v", events[i].Timestamp, listed[i].Timestamp)
+		}
+	}
+}
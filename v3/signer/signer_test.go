@@ -0,0 +1,136 @@
+package signer
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSigner(t *testing.T) {
+	expiration := time.Unix(1700000000, 0)
+
+	for _, test := range []struct {
+		name   string
+		method string
+		url    string
+		body   string
+		want   string
+	}{
+		{
+			name:   "body and query args",
+			method: http.MethodPost,
+			url:    "https://api-ch-gva-2.exoscale.com/v2/instance/a%20b?zone=ch-gva-2&tag=a&tag=b&name=x",
+			body:   `{"name":"test"}`,
+			want:   "EXO2-HMAC-SHA256 credential=EXOtest,signed-query-args=name;zone,expires=1700000000,signature=B6kK4lLw7lgTSbamL00qjDOUIDZUN5HY1icsw2yFaaU=",
+		},
+		{
+			name:   "no body",
+			method: http.MethodGet,
+			url:    "https://api-ch-gva-2.exoscale.com/v2/zone",
+			want:   "EXO2-HMAC-SHA256 credential=EXOtest,expires=1700000000,signature=G2KewAVmYQBTZ7WA3GqRxsfuBsevggWFly+cQxGO2UQ=",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var body io.Reader
+			if test.body != "" {
+				body = strings.NewReader(test.body)
+			}
+			req, err := http.NewRequest(test.method, test.url, body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := New("EXOtest", "secret").Sign(req, expiration); err != nil {
+				t.Fatal(err)
+			}
+			if got := req.Header.Get("Authorization"); got != test.want {
+				t.Fatalf("expected %q, got %q", test.want, got)
+			}
+
+			// The body can still be sent.
+			if req.Body != nil {
+				data, _ := io.ReadAll(req.Body)
+				if string(data) != test.body {
+					t.Fatalf("expected body %q, got %q", test.body, data)
+				}
+			}
+
+			auth, err := ParseAuthorization(test.want)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if auth.String() != test.want {
+				t.Fatalf("expected %q, got %q", test.want, auth.String())
+			}
+		})
+	}
+}
+
+func TestVerifier(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	verifier := NewVerifier(
+		StaticSecrets(map[string]string{"EXOtest": "secret"}),
+		VerifierOptWithClock(func() time.Time { return now }),
+	)
+
+	newRequest := func(key, secret, body string, expiration time.Time) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/v2/instance?zone=ch-gva-2", strings.NewReader(`{"name":"test"}`))
+		if err := New(key, secret).Sign(req, expiration); err != nil {
+			t.Fatal(err)
+		}
+		if body != "" {
+			req.Body = io.NopCloser(strings.NewReader(body))
+		}
+		return req
+	}
+
+	// withQuery replaces the query of a signed request.
+	withQuery := func(req *http.Request, query string) *http.Request {
+		req.URL.RawQuery = query
+		return req
+	}
+
+	for _, test := range []struct {
+		name string
+		req  *http.Request
+		want error
+	}{
+		{name: "valid", req: newRequest("EXOtest", "secret", "", now.Add(time.Minute))},
+		{name: "expired", req: newRequest("EXOtest", "secret", "", now), want: ErrSignatureExpired},
+		{name: "wrong secret", req: newRequest("EXOtest", "wrong", "", now.Add(time.Minute)), want: ErrSignatureMismatch},
+		{name: "tampered body", req: newRequest("EXOtest", "secret", `{"name":"evil"}`, now.Add(time.Minute)), want: ErrSignatureMismatch},
+		{name: "unknown credential", req: newRequest("EXOother", "secret", "", now.Add(time.Minute)), want: ErrUnknownCredential},
+		{name: "missing", req: httptest.NewRequest(http.MethodGet, "/v2/zone", nil), want: ErrMissingAuthorization},
+		{name: "unsigned query arg", req: withQuery(newRequest("EXOtest", "secret", "", now.Add(time.Minute)), "name=evil"), want: ErrSignatureMismatch},
+		{name: "missing query arg", req: withQuery(newRequest("EXOtest", "secret", "", now.Add(time.Minute)), ""), want: ErrSignatureMismatch},
+		{name: "multi-valued query arg", req: withQuery(newRequest("EXOtest", "secret", "", now.Add(time.Minute)), "zone=ch-gva-2&tag=a&tag=b")},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := verifier.Verify(test.req)
+			if test.want == nil {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			if !errors.Is(err, test.want) {
+				t.Fatalf("expected %v, got %v", test.want, err)
+			}
+		})
+	}
+
+	for _, header := range []string{
+		"AWS4-HMAC-SHA256 credential=EXOtest,expires=1700000000,signature=abc",
+		"EXO2-HMAC-SHA256 credential=EXOtest,expires=soon,signature=abc",
+		"EXO2-HMAC-SHA256 credential=EXOtest,signature=abc",
+		"EXO2-HMAC-SHA256 credential=EXOtest,expires=1700000000,signature",
+	} {
+		if _, err := ParseAuthorization(header); !errors.Is(err, ErrMalformedAuthorization) {
+			t.Fatalf("%q: expected %v, got %v", header, ErrMalformedAuthorization, err)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package signer
+
+import (
+	"crypto/hmac"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrMissingAuthorization is returned when the request has no Authorization header.
+	ErrMissingAuthorization = errors.New("missing Authorization header")
+	// ErrMalformedAuthorization is returned when the Authorization header cannot be parsed.
+	ErrMalformedAuthorization = errors.New("malformed Authorization header")
+	// ErrUnknownCredential is returned by a SecretFunc when the API key is unknown.
+	ErrUnknownCredential = errors.New("unknown credential")
+	// ErrSignatureExpired is returned when the signature expiration time is past.
+	ErrSignatureExpired = errors.New("signature expired")
+	// ErrSignatureMismatch is returned when the signature does not match the request.
+	ErrSignatureMismatch = errors.New("signature mismatch")
+)
+
+// ParseAuthorization parses an EXO2-HMAC-SHA256 Authorization header value.
+func ParseAuthorization(header string) (*Authorization, error) {
+	params, ok := strings.CutPrefix(header, Algorithm+" ")
+	if !ok {
+		return nil, fmt.Errorf("%w: unsupported algorithm", ErrMalformedAuthorization)
+	}
+
+	auth := &Authorization{}
+	var hasExpires bool
+	for _, param := range strings.Split(params, ",") {
+		name, value, ok := strings.Cut(strings.TrimSpace(param), "=")
+		if !ok {
+			return nil, fmt.Errorf("%w: invalid parameter %q", ErrMalformedAuthorization, param)
+		}
+
+		switch name {
+		case "credential":
+			auth.Credential = value
+		case "signed-query-args":
+			auth.SignedQueryArgs = strings.Split(value, ";")
+		case "expires":
+			ts, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%w: invalid expires %q", ErrMalformedAuthorization, value)
+			}
+			auth.Expires = time.Unix(ts, 0)
+			hasExpires = true
+		case "signature":
+			auth.Signature = value
+		default:
+			return nil, fmt.Errorf("%w: unknown parameter %q", ErrMalformedAuthorization, name)
+		}
+	}
+
+	switch {
+	case auth.Credential == "":
+		return nil, fmt.Errorf("%w: missing credential", ErrMalformedAuthorization)
+	case !hasExpires:
+		return nil, fmt.Errorf("%w: missing expires", ErrMalformedAuthorization)
+	case auth.Signature == "":
+		return nil, fmt.Errorf("%w: missing signature", ErrMalformedAuthorization)
+	}
+
+	return auth, nil
+}
+
+// SecretFunc returns the API secret of an API key, or an error wrapping ErrUnknownCredential.
+type SecretFunc func(apiKey string) (string, error)
+
+// StaticSecrets returns a SecretFunc looking up API secrets in a map indexed by API key.
+func StaticSecrets(secrets map[string]string) SecretFunc {
+	return func(apiKey string) (string, error) {
+		secret, ok := secrets[apiKey]
+		if !ok {
+			return "", fmt.Errorf("%w: %q", ErrUnknownCredential, apiKey)
+		}
+
+		return secret, nil
+	}
+}
+
+// Verifier verifies the signature of HTTP requests, e.g. to build local stand-ins
+// of the Exoscale API or test fixtures.
+type Verifier struct {
+	secrets SecretFunc
+	now     func() time.Time
+}
+
+// VerifierOpt represents a Verifier option.
+type VerifierOpt func(*Verifier)
+
+// VerifierOptWithClock returns a VerifierOpt setting the function returning the current time
+// signature expirations are checked against (time.Now by default).
+func VerifierOptWithClock(now func() time.Time) VerifierOpt {
+	return func(v *Verifier) {
+		v.now = now
+	}
+}
+
+// NewVerifier returns a Verifier retrieving the API secrets with the given SecretFunc.
+func NewVerifier(secrets SecretFunc, opts ...VerifierOpt) *Verifier {
+	v := &Verifier{
+		secrets: secrets,
+		now:     time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(v)
+	}
+
+	return v
+}
+
+// Verify checks the signature of the request, and returns its parsed Authorization header.
+// The single-valued query parameters of the request must be exactly the signed ones:
+// parameters added after signing are rejected.
+// The request body is read and replaced by an in-memory copy, so it can be read again by the caller.
+func (v *Verifier) Verify(req *http.Request) (*Authorization, error) {
+	header := req.Header.Get("Authorization")
+	if header == "" {
+		return nil, ErrMissingAuthorization
+	}
+
+	auth, err := ParseAuthorization(header)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := v.secrets(auth.Credential)
+	if err != nil {
+		return auth, err
+	}
+
+	signed := slices.Sorted(slices.Values(auth.SignedQueryArgs))
+	if actual := signedQueryArgs(req); !slices.Equal(signed, actual) {
+		return auth, fmt.Errorf("%w: signed query args %q, request query args %q", ErrSignatureMismatch, signed, actual)
+	}
+
+	body, err := readBody(req)
+	if err != nil {
+		return auth, fmt.Errorf("read request body: %w", err)
+	}
+
+	expected := signature(secret, req, body, auth.SignedQueryArgs, auth.Expires)
+	if !hmac.Equal([]byte(expected), []byte(auth.Signature)) {
+		return auth, ErrSignatureMismatch
+	}
+
+	if !v.now().Before(auth.Expires) {
+		return auth, fmt.Errorf("%w at %s", ErrSignatureExpired, auth.Expires.UTC().Format(time.RFC3339))
+	}
+
+	return auth, nil
+}
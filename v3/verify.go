@@ -0,0 +1,112 @@
+package v3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+var (
+	// ErrInvalidCredentials is returned by VerifyCredentials when the API rejects the credentials
+	// with HTTP 401: the API key is unknown or the secret wrong.
+	ErrInvalidCredentials = errors.New("invalid credentials")
+	// ErrRevokedCredentials is returned by VerifyCredentials when the API rejects the credentials
+	// with HTTP 401 while it accepted the same API key earlier in the client lifetime.
+	ErrRevokedCredentials = errors.New("revoked credentials")
+	// ErrInsufficientPermissions is returned by VerifyCredentials when the API rejects the credentials
+	// with HTTP 403: the IAM role of the API key does not allow the verification call.
+	ErrInsufficientPermissions = errors.New("insufficient IAM permissions")
+	// ErrClockSkew is returned by VerifyCredentials when the request signature was rejected
+	// because of the local clock being skewed relative to the API.
+	ErrClockSkew = errors.New("clock skew")
+)
+
+// CredentialsError is the error returned by VerifyCredentials when the credentials were rejected.
+// It wraps the classification error (e.g. ErrRevokedCredentials) and the API error.
+type CredentialsError struct {
+	// Reason is one of ErrInvalidCredentials, ErrRevokedCredentials, ErrInsufficientPermissions or ErrClockSkew.
+	Reason error
+	// APIKey is the API key of the rejected credentials.
+	APIKey string
+	// ProviderName is the name of the provider the credentials were retrieved from.
+	ProviderName string
+	// ClockSkew is the offset relative to the API of the clock the request was signed with,
+	// positive if ahead: the local clock, compensated unless disabled
+	// (see ClientOptWithClockSkewCompensation).
+	ClockSkew time.Duration
+	// Err is the API error.
+	Err error
+}
+
+func (e *CredentialsError) Error() string {
+	msg := fmt.Sprintf("%s: API key %q", e.Reason, e.APIKey)
+	if e.ProviderName != "" {
+		msg += " from " + e.ProviderName
+	}
+	if errors.Is(e.Reason, ErrClockSkew) {
+		msg += fmt.Sprintf(" (local clock offset %s)", e.ClockSkew)
+	}
+
+	return msg + ": " + e.Err.Error()
+}
+
+func (e *CredentialsError) Unwrap() []error { return []error{e.Reason, e.Err} }
+
+// VerifyCredentials performs a cheap authenticated API call to verify the client credentials.
+// If the API rejects them, it returns a *CredentialsError classifying the cause from the HTTP
+// status of the response and the clock skew relative to its Date header.
+func (c *Client) VerifyCredentials(ctx context.Context) error {
+	if _, err := c.credentials.Get(); err != nil {
+		return fmt.Errorf("verify credentials: retrieve credentials: %w", err)
+	}
+
+	var response *http.Response
+	client := c.WithMiddleware(func(next Handler) Handler {
+		return func(ctx context.Context, call *Call) error {
+			err := next(ctx, call)
+			response = call.Response
+			return err
+		}
+	})
+
+	// The signature expiration is computed from the compensated clock.
+	signed := c.clock.Now()
+	_, err := client.GetOrganization(ctx)
+	if err == nil {
+		return nil
+	}
+
+	if response == nil || !errors.As(err, new(*APIError)) || !isAuthFailure(response) {
+		return fmt.Errorf("verify credentials: %w", err)
+	}
+
+	// The credentials may have been refreshed on rejection.
+	creds, _ := c.credentials.Get()
+	credsErr := &CredentialsError{
+		APIKey:       creds.APIKey,
+		ProviderName: creds.ProviderName,
+		Err:          err,
+	}
+	if date, err := http.ParseTime(response.Header.Get("Date")); err == nil {
+		// The Date header has a one second precision.
+		credsErr.ClockSkew = signed.Sub(date).Truncate(time.Second)
+	}
+
+	// Beyond half the signature validity, a rejected signature is attributed to the clock.
+	maxClockSkew := c.getSignatureValidity() / 2
+
+	switch _, accepted := c.acceptedKeys.Load(creds.APIKey); {
+	case credsErr.ClockSkew > maxClockSkew || credsErr.ClockSkew < -maxClockSkew:
+		credsErr.Reason = ErrClockSkew
+	case response.StatusCode == http.StatusForbidden:
+		credsErr.Reason = ErrInsufficientPermissions
+	case accepted:
+		credsErr.Reason = ErrRevokedCredentials
+	default:
+		credsErr.Reason = ErrInvalidCredentials
+	}
+
+	return credsErr
+}
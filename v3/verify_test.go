@@ -0,0 +1,88 @@
+package v3
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/exoscale/egoscale/v3/credentials"
+)
+
+func TestVerifyCredentials(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		status   int
+		body     string
+		accepted bool
+		skew     time.Duration
+		validity time.Duration
+		want     error
+	}{
+		{name: "valid", status: http.StatusOK, body: `{"name":"test"}`},
+		{name: "invalid", status: http.StatusUnauthorized, body: `{"message":"Invalid request signature"}`, want: ErrInvalidCredentials},
+		{name: "revoked", status: http.StatusUnauthorized, body: `{"message":"Invalid request signature"}`, accepted: true, want: ErrRevokedCredentials},
+		{name: "forbidden", status: http.StatusForbidden, body: `{"message":"Forbidden"}`, want: ErrInsufficientPermissions},
+		{
+			name:   "forbidden problem details",
+			status: http.StatusForbidden,
+			body:   `{"type":"about:blank","title":"Forbidden","status":403,"detail":"invalid IAM role for operation get-organization"}`,
+			want:   ErrInsufficientPermissions,
+		},
+		{name: "clock skew", status: http.StatusUnauthorized, body: `{"message":"Invalid request signature"}`, skew: time.Hour, want: ErrClockSkew},
+		{name: "clock skew within validity", status: http.StatusForbidden, body: `{"message":"Forbidden"}`, skew: 20 * time.Minute, validity: time.Hour, want: ErrInsufficientPermissions},
+		{name: "server error", status: http.StatusInternalServerError, body: `{"message":"Internal Server Error"}`, want: ErrInternalServerError},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var opts []ClientOpt
+			if test.validity != 0 {
+				opts = append(opts, ClientOptWithSignatureValidity(test.validity))
+			}
+			var verified atomic.Bool
+			client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Date", time.Now().Add(-test.skew).UTC().Format(http.TimeFormat))
+				w.Header().Set("Content-Type", "application/json")
+				if !verified.Load() {
+					_, _ = w.Write([]byte(`{"name":"test"}`))
+					return
+				}
+				w.WriteHeader(test.status)
+				_, _ = w.Write([]byte(test.body))
+			}, opts...)
+
+			// The API key was accepted before being revoked.
+			if test.accepted {
+				if _, err := client.GetOrganization(context.Background()); err != nil {
+					t.Fatal(err)
+				}
+			}
+			verified.Store(true)
+
+			err := client.VerifyCredentials(context.Background())
+			if test.want == nil {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			if !errors.Is(err, test.want) {
+				t.Fatalf("expected %v, got %v", test.want, err)
+			}
+
+			var credsErr *CredentialsError
+			if test.status != http.StatusInternalServerError {
+				if !errors.As(err, &credsErr) {
+					t.Fatalf("expected a *CredentialsError, got %v", err)
+				}
+				if credsErr.APIKey != "EXOtest" || credsErr.ProviderName != credentials.StaticProviderName {
+					t.Errorf("unexpected credentials %q from %q", credsErr.APIKey, credsErr.ProviderName)
+				}
+				if test.skew != 0 && (credsErr.ClockSkew < test.skew-time.Minute || credsErr.ClockSkew > test.skew+time.Minute) {
+					t.Errorf("expected clock skew around %v, got %v", test.skew, credsErr.ClockSkew)
+				}
+			}
+		})
+	}
+}
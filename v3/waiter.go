@@ -0,0 +1,336 @@
+package v3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// waitAbortErrorsCount is the number of consecutive errors polling an operation aborts waiting after.
+const waitAbortErrorsCount = 5
+
+// OperationFailedError is the error returned when an operation reached a final state
+// other than the expected ones.
+type OperationFailedError struct {
+	// ID is the operation ID.
+	ID UUID
+	// State is the final state of the operation.
+	State OperationState
+	// Reason is the failure reason of the operation.
+	Reason OperationReason
+	// Message is the operation message.
+	Message string
+	// Reference is the reference of the resource the operation relates to, if any.
+	Reference *OperationReference
+}
+
+func newOperationFailedError(op *Operation) *OperationFailedError {
+	return &OperationFailedError{
+		ID:        op.ID,
+		State:     op.State,
+		Reason:    op.Reason,
+		Message:   op.Message,
+		Reference: op.Reference,
+	}
+}
+
+func (e *OperationFailedError) Error() string {
+	var ref OperationReference
+	if e.Reference != nil {
+		ref = *e.Reference
+	}
+
+	return fmt.Sprintf("operation: %q %v, state: %s, reason: %q, message: %q",
+		e.ID,
+		ref,
+		e.State,
+		e.Reason,
+		e.Message,
+	)
+}
+
+// PollStrategy returns the interval before the next poll of operations,
+// based on the time elapsed since waiting started.
+// Intervals shorter than a millisecond are raised to a millisecond.
+type PollStrategy func(runTime time.Duration) time.Duration
+
+// minPollInterval is the minimum interval between two polls of operations.
+const minPollInterval = time.Millisecond
+
+// DefaultPollStrategy polls every 3 seconds for the first 30 seconds, then increases
+// the interval linearly to reach 1 minute at 15 minutes of runtime.
+func DefaultPollStrategy() PollStrategy {
+	return pollInterval
+}
+
+// ConstantPollStrategy polls at a constant interval.
+func ConstantPollStrategy(interval time.Duration) PollStrategy {
+	return func(time.Duration) time.Duration {
+		return interval
+	}
+}
+
+// WaitProgressFn is the function signature of the callback called with
+// the last state of an operation each time it is polled.
+type WaitProgressFn func(op *Operation, runTime time.Duration)
+
+// Waiter waits for async operations to reach a final state (one of: failure, success, timeout).
+// Operations waited for together with WaitAll or WaitAny are polled in a single loop.
+type Waiter struct {
+	client       *Client
+	states       []OperationState
+	pollStrategy PollStrategy
+	progress     WaitProgressFn
+}
+
+// WaiterOpt represents a Waiter option.
+type WaiterOpt func(*Waiter)
+
+// WaiterOptWithStates returns a WaiterOpt failing with an *OperationFailedError
+// when operations reach a final state other than the given ones.
+func WaiterOptWithStates(states ...OperationState) WaiterOpt {
+	return func(w *Waiter) {
+		w.states = append(w.states, states...)
+	}
+}
+
+// WaiterOptWithPollStrategy returns a WaiterOpt setting the strategy used to poll operations.
+func WaiterOptWithPollStrategy(s PollStrategy) WaiterOpt {
+	return func(w *Waiter) {
+		w.pollStrategy = s
+	}
+}
+
+// WaiterOptWithProgress returns a WaiterOpt setting a callback called
+// with the last state of an operation each time it is polled.
+func WaiterOptWithProgress(fn WaitProgressFn) WaiterOpt {
+	return func(w *Waiter) {
+		w.progress = fn
+	}
+}
+
+// NewWaiter returns a new Waiter polling operations with the client.
+// The client wait timeout (see ClientOptWithWaitTimeout) applies to the waiter.
+func (c *Client) NewWaiter(opts ...WaiterOpt) *Waiter {
+	w := &Waiter{
+		client:       c,
+		pollStrategy: pollInterval,
+	}
+
+	for _, opt := range opts {
+		opt(w)
+	}
+
+	return w
+}
+
+// Wait waits for the operation to reach a final state.
+// If the operation is not pending, it is returned as is.
+func (w *Waiter) Wait(ctx context.Context, op *Operation) (*Operation, error) {
+	ctx, end := w.client.traceWait(ctx, "wait-operation", []*Operation{op})
+
+	ops, errs := w.poll(ctx, []*Operation{op}, false)
+
+	var operation *Operation
+	if ops != nil {
+		operation = ops[0]
+	}
+	if len(errs) > 0 {
+		end(ops, errs[0])
+		return nil, errs[0]
+	}
+
+	end(ops, nil)
+
+	return operation, nil
+}
+
+// WaitAll waits for all the operations to reach a final state, returning their final
+// state in the same order. If some operations did not reach an expected state,
+// the final operations are returned along with their *OperationFailedError, joined.
+func (w *Waiter) WaitAll(ctx context.Context, ops ...*Operation) ([]*Operation, error) {
+	ctx, end := w.client.traceWait(ctx, "wait-all-operations", ops)
+
+	done, errs := w.poll(ctx, ops, false)
+	if done == nil {
+		end(nil, errs[0])
+		return nil, errs[0]
+	}
+
+	err := errors.Join(errs...)
+	end(done, err)
+
+	return done, err
+}
+
+// WaitAny waits for the first of the operations to reach a final state and returns it.
+func (w *Waiter) WaitAny(ctx context.Context, ops ...*Operation) (*Operation, error) {
+	ctx, end := w.client.traceWait(ctx, "wait-any-operation", ops)
+
+	done, errs := w.poll(ctx, ops, true)
+	if len(errs) > 0 {
+		end(done, errs[0])
+		return nil, errs[0]
+	}
+
+	for _, op := range done {
+		if op != nil {
+			end(done, nil)
+			return op, nil
+		}
+	}
+
+	err := fmt.Errorf("no operation done")
+	end(done, err)
+
+	return nil, err
+}
+
+// pollInterval returns the interval before the next poll of operations, at least minPollInterval.
+func (w *Waiter) pollInterval(runTime time.Duration) time.Duration {
+	return max(w.pollStrategy(runTime), minPollInterval)
+}
+
+// poll polls the operations until they all reached a final state, or one of them if first is true.
+// Operations are returned along with the errors of those which did not reach an expected state,
+// or nil along with the single error aborting polling.
+func (w *Waiter) poll(ctx context.Context, ops []*Operation, first bool) ([]*Operation, []error) {
+	if len(ops) == 0 {
+		return nil, []error{fmt.Errorf("no operation to wait for")}
+	}
+
+	var (
+		done    = make([]*Operation, len(ops))
+		pending = make(map[int]int) // Index of pending operations to their subsequent polling errors.
+		errs    []error
+	)
+
+	finish := func(i int, op *Operation) {
+		done[i] = op
+		delete(pending, i)
+		if err := w.checkState(op); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for i, op := range ops {
+		if op == nil {
+			return nil, []error{fmt.Errorf("operation is nil")}
+		}
+
+		if op.State != OperationStatePending {
+			// Operations already in a final state are returned as is.
+			done[i] = op
+			if first {
+				return done, nil
+			}
+			continue
+		}
+		pending[i] = 0
+	}
+
+	if len(pending) == 0 {
+		return done, errs
+	}
+
+	startTime := time.Now()
+
+	ticker := time.NewTicker(w.pollInterval(0))
+	defer ticker.Stop()
+
+	for len(pending) > 0 {
+		select {
+		case <-ticker.C:
+			runTime := time.Since(startTime)
+
+			if w.client.waitTimeout != 0 && runTime > w.client.waitTimeout {
+				for i := range ops {
+					if _, ok := pending[i]; ok {
+						return nil, []error{fmt.Errorf("operation: %q: max wait timeout reached", ops[i].ID)}
+					}
+				}
+			}
+
+			ticker.Reset(w.pollInterval(runTime))
+
+			// The same operation is polled once per tick.
+			polled := make(map[UUID]*Operation)
+			for i := range ops {
+				subsequentErrors, ok := pending[i]
+				if !ok {
+					continue
+				}
+
+				o, ok := polled[ops[i].ID]
+				if !ok {
+					var err error
+					o, err = w.client.GetOperation(ctx, ops[i].ID)
+					if err != nil {
+						if ctx.Err() != nil {
+							return nil, []error{ctx.Err()}
+						}
+
+						pending[i] = subsequentErrors + 1
+						if pending[i] >= waitAbortErrorsCount {
+							return nil, []error{err}
+						}
+						continue
+					}
+					polled[ops[i].ID] = o
+				}
+				pending[i] = 0
+
+				if w.progress != nil {
+					w.progress(o, runTime)
+				}
+
+				if o.State == OperationStatePending {
+					continue
+				}
+
+				finish(i, o)
+				if first {
+					return done, errs
+				}
+			}
+		case <-ctx.Done():
+			return nil, []error{ctx.Err()}
+		}
+	}
+
+	return done, errs
+}
+
+// checkState returns an *OperationFailedError if the operation final state is not an expected one.
+func (w *Waiter) checkState(op *Operation) error {
+	if len(w.states) == 0 {
+		return nil
+	}
+
+	for _, st := range w.states {
+		if op.State == st {
+			return nil
+		}
+	}
+
+	return newOperationFailedError(op)
+}
+
+// ErrMissingOperationReference is returned when a succeeded operation does not reference the resource it created.
+var ErrMissingOperationReference = errors.New("missing operation reference")
+
+// OperationError is the error returned by the AndWait methods once the operation was created,
+// wrapping the error of waiting for the operation or fetching the resulting resource.
+type OperationError struct {
+	// ID is the operation ID.
+	ID UUID
+	// Err is the wrapped error.
+	Err error
+}
+
+func (e *OperationError) Error() string {
+	return fmt.Sprintf("operation %q: %v", e.ID, e.Err)
+}
+
+func (e *OperationError) Unwrap() error { return e.Err }
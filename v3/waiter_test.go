@@ -0,0 +1,182 @@
+package v3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// newTestOperationsClient returns a Client polling operations which reach
+// the given final state after the given number of polls, other operations staying pending.
+func newTestOperationsClient(t *testing.T, final map[UUID]OperationState, polls int) (*Client, map[UUID]int) {
+	t.Helper()
+
+	var mu sync.Mutex
+	requests := make(map[UUID]int)
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		id := UUID(strings.TrimPrefix(r.URL.Path, "/operation/"))
+
+		mu.Lock()
+		requests[id]++
+		state := OperationStatePending
+		if s, ok := final[id]; ok && requests[id] >= polls {
+			state = s
+		}
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"id":%q,"state":%q,"reason":"incorrect","message":"test",`+
+			`"reference":{"id":"bb2dcf4a-3e1b-4ce9-8f3c-6a8b1d5f0a27","command":"create-instance"}}`, id, state)
+	})
+
+	return client, requests
+}
+
+func TestWaiterWait(t *testing.T) {
+	const (
+		succeeded = UUID("d3e8d1a6-7a3c-4d5e-9b1f-2d4c5e6f7a01")
+		failed    = UUID("d3e8d1a6-7a3c-4d5e-9b1f-2d4c5e6f7a02")
+	)
+
+	client, _ := newTestOperationsClient(t, map[UUID]OperationState{
+		succeeded: OperationStateSuccess,
+		failed:    OperationStateFailure,
+	}, 3)
+
+	var progress []OperationState
+	waiter := client.NewWaiter(
+		WaiterOptWithStates(OperationStateSuccess),
+		WaiterOptWithPollStrategy(ConstantPollStrategy(time.Millisecond)),
+		WaiterOptWithProgress(func(op *Operation, runTime time.Duration) {
+			progress = append(progress, op.State)
+		}),
+	)
+
+	ctx := context.Background()
+	op, err := waiter.Wait(ctx, &Operation{ID: succeeded, State: OperationStatePending})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if op.State != OperationStateSuccess {
+		t.Errorf("expected state %q, got %q", OperationStateSuccess, op.State)
+	}
+	expected := []OperationState{OperationStatePending, OperationStatePending, OperationStateSuccess}
+	if fmt.Sprint(progress) != fmt.Sprint(expected) {
+		t.Errorf("expected progress %v, got %v", expected, progress)
+	}
+
+	_, err = waiter.Wait(ctx, &Operation{ID: failed, State: OperationStatePending})
+	var opErr *OperationFailedError
+	if !errors.As(err, &opErr) {
+		t.Fatalf("expected an *OperationFailedError, got %v", err)
+	}
+	if opErr.State != OperationStateFailure || opErr.Reason != OperationReasonIncorrect ||
+		opErr.Message != "test" || opErr.Reference == nil || opErr.Reference.Command != "create-instance" {
+		t.Errorf("unexpected error %+v", opErr)
+	}
+	if want := `operation: "d3e8d1a6-7a3c-4d5e-9b1f-2d4c5e6f7a02" {create-instance bb2dcf4a-3e1b-4ce9-8f3c-6a8b1d5f0a27 }, ` +
+		`state: failure, reason: "incorrect", message: "test"`; err.Error() != want {
+		t.Errorf("expected error %q, got %q", want, err.Error())
+	}
+}
+
+func TestWaiterWaitAll(t *testing.T) {
+	const (
+		first  = UUID("d3e8d1a6-7a3c-4d5e-9b1f-2d4c5e6f7a01")
+		second = UUID("d3e8d1a6-7a3c-4d5e-9b1f-2d4c5e6f7a02")
+	)
+
+	client, requests := newTestOperationsClient(t, map[UUID]OperationState{
+		first:  OperationStateSuccess,
+		second: OperationStateFailure,
+	}, 2)
+	waiter := client.NewWaiter(
+		WaiterOptWithStates(OperationStateSuccess),
+		WaiterOptWithPollStrategy(ConstantPollStrategy(time.Millisecond)),
+	)
+
+	ops, err := waiter.WaitAll(context.Background(),
+		&Operation{ID: first, State: OperationStatePending},
+		&Operation{ID: second, State: OperationStatePending},
+		&Operation{ID: first, State: OperationStatePending},
+	)
+
+	var opErr *OperationFailedError
+	if !errors.As(err, &opErr) || opErr.ID != second {
+		t.Errorf("expected an *OperationFailedError for %q, got %v", second, err)
+	}
+	if len(ops) != 3 || ops[0].ID != first || ops[1].State != OperationStateFailure || ops[2].ID != first {
+		t.Errorf("unexpected operations %+v", ops)
+	}
+	// Operations waited for more than once are polled once per tick.
+	if requests[first] != 2 || requests[second] != 2 {
+		t.Errorf("unexpected requests count %v", requests)
+	}
+}
+
+func TestWaiterWaitAny(t *testing.T) {
+	const (
+		slow = UUID("d3e8d1a6-7a3c-4d5e-9b1f-2d4c5e6f7a01")
+		fast = UUID("d3e8d1a6-7a3c-4d5e-9b1f-2d4c5e6f7a02")
+	)
+
+	client, _ := newTestOperationsClient(t, map[UUID]OperationState{fast: OperationStateSuccess}, 1)
+	waiter := client.NewWaiter(WaiterOptWithPollStrategy(ConstantPollStrategy(time.Millisecond)))
+
+	op, err := waiter.WaitAny(context.Background(),
+		&Operation{ID: slow, State: OperationStatePending},
+		&Operation{ID: fast, State: OperationStatePending},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if op.ID != fast {
+		t.Errorf("expected operation %q, got %q", fast, op.ID)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := waiter.WaitAny(ctx, &Operation{ID: slow, State: OperationStatePending}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected %v, got %v", context.DeadlineExceeded, err)
+	}
+}
+
+func TestWaiterZeroPollInterval(t *testing.T) {
+	const id = UUID("d3e8d1a6-7a3c-4d5e-9b1f-2d4c5e6f7a01")
+
+	client, _ := newTestOperationsClient(t, map[UUID]OperationState{id: OperationStateSuccess}, 2)
+
+	for _, strategy := range []PollStrategy{
+		ConstantPollStrategy(0),
+		func(time.Duration) time.Duration { return -time.Second },
+	} {
+		op, err := client.NewWaiter(WaiterOptWithPollStrategy(strategy)).
+			Wait(context.Background(), &Operation{ID: id, State: OperationStatePending})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if op.State != OperationStateSuccess {
+			t.Errorf("expected state %q, got %q", OperationStateSuccess, op.State)
+		}
+	}
+
+	// Nothing is polled for operations already done.
+	op, err := client.NewWaiter(WaiterOptWithPollStrategy(func(time.Duration) time.Duration {
+		t.Error("unexpected poll")
+		return time.Second
+	})).Wait(context.Background(), &Operation{ID: id, State: OperationStateSuccess})
+	if err != nil || op.State != OperationStateSuccess {
+		t.Fatalf("unexpected operation %+v (error: %v)", op, err)
+	}
+
+	// Operations already done are returned as is, whatever the expected states.
+	op, err = client.Wait(context.Background(), &Operation{ID: id, State: OperationStateFailure}, OperationStateSuccess)
+	if err != nil || op.State != OperationStateFailure {
+		t.Fatalf("unexpected operation %+v (error: %v)", op, err)
+	}
+}
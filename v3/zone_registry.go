@@ -0,0 +1,264 @@
+package v3
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+)
+
+// defaultZoneRegistryTTL is the default duration zones are cached for.
+const defaultZoneRegistryTTL = time.Hour
+
+// defaultZoneRegistryTimeout is the default maximum duration of listing the zones.
+const defaultZoneRegistryTimeout = 30 * time.Second
+
+// ZoneRegistry resolves zone names to API endpoints (and conversely),
+// caching the zones list for a TTL, optionally on disk so it survives restarts.
+// A ZoneRegistry is safe for concurrent use, and shared by cloned clients.
+type ZoneRegistry struct {
+	ttl       time.Duration
+	timeout   time.Duration
+	cacheFile string
+	now       func() time.Time
+
+	mu        sync.Mutex
+	zones     []Zone
+	fetchedAt time.Time
+	fetching  *zoneFetch
+}
+
+// zoneFetch represents an in-flight listing of the zones, shared by concurrent lookups.
+type zoneFetch struct {
+	done  chan struct{}
+	zones []Zone
+	err   error
+}
+
+// ZoneRegistryOpt represents a ZoneRegistry option.
+type ZoneRegistryOpt func(*ZoneRegistry)
+
+// ZoneRegistryOptWithTTL returns a ZoneRegistryOpt setting the duration zones are cached for.
+func ZoneRegistryOptWithTTL(ttl time.Duration) ZoneRegistryOpt {
+	return func(r *ZoneRegistry) {
+		r.ttl = ttl
+	}
+}
+
+// ZoneRegistryOptWithTimeout returns a ZoneRegistryOpt setting the maximum duration of listing the zones
+// (30 seconds by default). The listing is shared by concurrent lookups, so it is not canceled along with
+// the context of the lookup starting it.
+func ZoneRegistryOptWithTimeout(timeout time.Duration) ZoneRegistryOpt {
+	return func(r *ZoneRegistry) {
+		r.timeout = timeout
+	}
+}
+
+// ZoneRegistryOptWithCacheFile returns a ZoneRegistryOpt caching zones in the given file,
+// shared by processes using the same file. The file is written on a best-effort basis.
+func ZoneRegistryOptWithCacheFile(path string) ZoneRegistryOpt {
+	return func(r *ZoneRegistry) {
+		r.cacheFile = path
+	}
+}
+
+// NewZoneRegistry returns a new ZoneRegistry.
+func NewZoneRegistry(opts ...ZoneRegistryOpt) *ZoneRegistry {
+	r := &ZoneRegistry{
+		ttl:     defaultZoneRegistryTTL,
+		timeout: defaultZoneRegistryTimeout,
+		now:     time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+// ClientOptWithZoneRegistry returns a ClientOpt setting the ZoneRegistry used to resolve zones,
+// e.g. to share it between clients. A nil ZoneRegistry disables caching.
+func ClientOptWithZoneRegistry(r *ZoneRegistry) ClientOpt {
+	return func(c *Client) error {
+		c.zoneRegistry = r
+		return nil
+	}
+}
+
+// Invalidate drops the cached zones, so they are fetched again on next lookup.
+func (r *ZoneRegistry) Invalidate() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.zones = nil
+	if r.cacheFile != "" {
+		_ = os.Remove(r.cacheFile)
+	}
+}
+
+// zoneCache represents the on-disk zone cache.
+type zoneCache struct {
+	FetchedAt time.Time `json:"fetched-at"`
+	Zones     []Zone    `json:"zones"`
+}
+
+// listZones returns the cached zones, fetching them with the client once expired, or if refresh is true.
+// The zones are listed in the background, once for concurrent lookups, which give up with their own context.
+// It reports whether the zones were fetched.
+func (r *ZoneRegistry) listZones(ctx context.Context, c *Client, refresh bool) (*ListZonesResponse, bool, error) {
+	r.mu.Lock()
+
+	if r.zones == nil && r.cacheFile != "" {
+		r.loadCacheFile()
+	}
+
+	if !refresh && r.zones != nil && r.now().Before(r.fetchedAt.Add(r.ttl)) {
+		defer r.mu.Unlock()
+		return &ListZonesResponse{Zones: slices.Clone(r.zones)}, false, nil
+	}
+
+	f := r.fetching
+	if f == nil {
+		f = &zoneFetch{done: make(chan struct{})}
+		r.fetching = f
+		go r.fetch(ctx, c, f)
+	}
+	r.mu.Unlock()
+
+	select {
+	case <-f.done:
+	case <-ctx.Done():
+		return nil, false, ctx.Err()
+	}
+
+	if f.err != nil {
+		return nil, false, f.err
+	}
+
+	return &ListZonesResponse{Zones: slices.Clone(f.zones)}, true, nil
+}
+
+// fetch lists the zones with the client, caching them and completing f.
+// The listing is shared by concurrent lookups: it is bounded by the registry timeout
+// rather than canceled along with the context of the lookup starting it.
+func (r *ZoneRegistry) fetch(ctx context.Context, c *Client, f *zoneFetch) {
+	defer func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		r.fetching = nil
+		close(f.done)
+	}()
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), r.timeout)
+	defer cancel()
+
+	resp, err := c.ListZones(ctx)
+	if err != nil {
+		f.err = err
+		return
+	}
+
+	r.mu.Lock()
+	r.zones = resp.Zones
+	r.fetchedAt = r.now()
+	if r.cacheFile != "" {
+		r.writeCacheFile()
+	}
+	r.mu.Unlock()
+
+	f.zones, f.err = resp.Zones, nil
+}
+
+func (r *ZoneRegistry) loadCacheFile() {
+	data, err := os.ReadFile(r.cacheFile)
+	if err != nil {
+		return
+	}
+
+	var cache zoneCache
+	if err := json.Unmarshal(data, &cache); err != nil || len(cache.Zones) == 0 {
+		return
+	}
+
+	r.zones = cache.Zones
+	r.fetchedAt = cache.FetchedAt
+}
+
+func (r *ZoneRegistry) writeCacheFile() {
+	data, err := json.Marshal(zoneCache{FetchedAt: r.fetchedAt, Zones: r.zones})
+	if err != nil {
+		return
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.cacheFile), 0o700); err != nil {
+		return
+	}
+
+	// Write to a temporary file first so concurrent readers never see a partial cache.
+	f, err := os.CreateTemp(filepath.Dir(r.cacheFile), filepath.Base(r.cacheFile)+".*")
+	if err != nil {
+		return
+	}
+	defer os.Remove(f.Name()) //nolint:errcheck
+
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return
+	}
+	if err := f.Close(); err != nil {
+		return
+	}
+
+	_ = os.Rename(f.Name(), r.cacheFile)
+}
+
+// listZones returns the zones, from the zone registry if any, fetching them again if refresh is true.
+// It reports whether the zones were fetched rather than read from the zone registry cache.
+func (c Client) listZones(ctx context.Context, refresh bool) (*ListZonesResponse, bool, error) {
+	if c.zoneRegistry != nil {
+		return c.zoneRegistry.listZones(ctx, &c, refresh)
+	}
+
+	resp, err := c.ListZones(ctx)
+	return resp, true, err
+}
+
+// findZone returns the zone matching the given name or API endpoint.
+// If the zone is missing from the cached zones, they are fetched again once.
+func (c Client) findZone(ctx context.Context, nameOrAPIEndpoint string) (Zone, error) {
+	resp, fetched, err := c.listZones(ctx, false)
+	if err != nil {
+		return Zone{}, fmt.Errorf("list zones: %w", err)
+	}
+
+	zone, err := resp.FindZone(nameOrAPIEndpoint)
+	if errors.Is(err, ErrNotFound) && !fetched {
+		// The zone may have been added since the zones were cached.
+		if resp, _, err = c.listZones(ctx, true); err != nil {
+			return Zone{}, fmt.Errorf("list zones: %w", err)
+		}
+		zone, err = resp.FindZone(nameOrAPIEndpoint)
+	}
+	if err != nil {
+		return Zone{}, fmt.Errorf("find zone: %w", err)
+	}
+
+	return zone, nil
+}
+
+// ClientForZone returns a copy of Client targeting the API endpoint of the given zone.
+func (c *Client) ClientForZone(ctx context.Context, zoneName ZoneName) (*Client, error) {
+	endpoint, err := c.GetZoneAPIEndpoint(ctx, zoneName)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.WithEndpoint(endpoint), nil
+}
@@ -0,0 +1,212 @@
+package v3
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTestZoneRegistryClient(t *testing.T, r *ZoneRegistry) (*Client, *int32) {
+	t.Helper()
+
+	return newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"zones":[` +
+			`{"name":"ch-gva-2","api-endpoint":"https://api-ch-gva-2.exoscale.com/v2"},` +
+			`{"name":"xx-new-1","api-endpoint":"https://api-xx-new-1.exoscale.com/v2"}]}`))
+	}, ClientOptWithZoneRegistry(r))
+}
+
+func TestZoneRegistry(t *testing.T) {
+	now := time.Now()
+	r := NewZoneRegistry(ZoneRegistryOptWithTTL(time.Minute))
+	r.now = func() time.Time { return now }
+
+	client, requests := newTestZoneRegistryClient(t, r)
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			endpoint, err := client.WithUserAgent("test").GetZoneAPIEndpoint(ctx, "xx-new-1")
+			if err != nil || endpoint != "https://api-xx-new-1.exoscale.com/v2" {
+				t.Errorf("unexpected endpoint %q (error: %v)", endpoint, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	name, err := client.GetZoneName(ctx, CHGva2)
+	if err != nil || name != ZoneNameCHGva2 {
+		t.Errorf("unexpected zone name %q (error: %v)", name, err)
+	}
+	if got := atomic.LoadInt32(requests); got != 1 {
+		t.Errorf("expected zones to be listed once, got %d", got)
+	}
+
+	// A lookup miss lists the zones again once.
+	if _, err := client.GetZoneAPIEndpoint(ctx, "xx-unknown-1"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected %v, got %v", ErrNotFound, err)
+	}
+	if got := atomic.LoadInt32(requests); got != 2 {
+		t.Errorf("expected zones to be listed again on a lookup miss, got %d requests", got)
+	}
+
+	now = now.Add(time.Minute)
+	zoneClient, err := client.ClientForZone(ctx, "xx-new-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if zoneClient.serverEndpoint != "https://api-xx-new-1.exoscale.com/v2" {
+		t.Errorf("unexpected client endpoint %q", zoneClient.serverEndpoint)
+	}
+	if got := atomic.LoadInt32(requests); got != 3 {
+		t.Errorf("expected expired zones to be listed again, got %d requests", got)
+	}
+}
+
+func TestZoneRegistryCacheFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache", "zones.json")
+	ctx := context.Background()
+
+	client, requests := newTestZoneRegistryClient(t, NewZoneRegistry(ZoneRegistryOptWithCacheFile(path)))
+	if _, err := client.GetZoneAPIEndpoint(ctx, ZoneNameCHGva2); err != nil {
+		t.Fatal(err)
+	}
+
+	// Another registry using the same file does not list zones again.
+	client, requests = newTestZoneRegistryClient(t, NewZoneRegistry(ZoneRegistryOptWithCacheFile(path)))
+	if _, err := client.GetZoneAPIEndpoint(ctx, "xx-new-1"); err != nil {
+		t.Fatal(err)
+	}
+	if got := atomic.LoadInt32(requests); got != 0 {
+		t.Errorf("expected zones to be read from the cache file, got %d requests", got)
+	}
+
+	client.zoneRegistry.Invalidate()
+	if _, err := client.GetZoneAPIEndpoint(ctx, "xx-new-1"); err != nil {
+		t.Fatal(err)
+	}
+	if got := atomic.LoadInt32(requests); got != 1 {
+		t.Errorf("expected invalidated zones to be listed again, got %d requests", got)
+	}
+}
+
+func TestZoneRegistryNewZone(t *testing.T) {
+	var added atomic.Bool
+	client, requests := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if !added.Load() {
+			_, _ = w.Write([]byte(`{"zones":[{"name":"ch-gva-2","api-endpoint":"https://api-ch-gva-2.exoscale.com/v2"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"zones":[` +
+			`{"name":"ch-gva-2","api-endpoint":"https://api-ch-gva-2.exoscale.com/v2"},` +
+			`{"name":"xx-new-1","api-endpoint":"https://api-xx-new-1.exoscale.com/v2"}]}`))
+	}, ClientOptWithZoneRegistry(NewZoneRegistry()))
+	ctx := context.Background()
+
+	resp, _, err := client.listZones(ctx, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The cached zones are not shared with callers.
+	resp.Zones[0].Name = "xx-modified-1"
+
+	added.Store(true)
+	endpoint, err := client.GetZoneAPIEndpoint(ctx, "xx-new-1")
+	if err != nil || endpoint != "https://api-xx-new-1.exoscale.com/v2" {
+		t.Errorf("unexpected endpoint %q (error: %v)", endpoint, err)
+	}
+	if _, err := client.GetZoneAPIEndpoint(ctx, ZoneNameCHGva2); err != nil {
+		t.Error(err)
+	}
+	if got := atomic.LoadInt32(requests); got != 2 {
+		t.Errorf("expected zones to be listed twice, got %d requests", got)
+	}
+}
+
+func TestZoneRegistryFetchUnlocked(t *testing.T) {
+	release := make(chan struct{})
+	r := NewZoneRegistry()
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"zones":[{"name":"ch-gva-2","api-endpoint":"https://api-ch-gva-2.exoscale.com/v2"}]}`))
+	}, ClientOptWithZoneRegistry(r))
+
+	done := make(chan error)
+	go func() {
+		_, err := client.GetZoneAPIEndpoint(context.Background(), ZoneNameCHGva2)
+		done <- err
+	}()
+
+	// Lookups waiting for the zones listing in flight give up with their context.
+	time.Sleep(10 * time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := client.GetZoneAPIEndpoint(ctx, ZoneNameCHGva2); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected %v, got %v", context.DeadlineExceeded, err)
+	}
+	r.Invalidate()
+
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestZoneRegistryFetchShared(t *testing.T) {
+	release := make(chan struct{})
+	client, requests := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"zones":[{"name":"ch-gva-2","api-endpoint":"https://api-ch-gva-2.exoscale.com/v2"}]}`))
+	}, ClientOptWithZoneRegistry(NewZoneRegistry()))
+
+	// The lookup starting the zones listing gives up with its context, the listing goes on.
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		_, err := client.GetZoneAPIEndpoint(ctx, ZoneNameCHGva2)
+		done <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("expected %v, got %v", context.Canceled, err)
+	}
+
+	go func() {
+		_, err := client.GetZoneAPIEndpoint(context.Background(), ZoneNameCHGva2)
+		done <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if got := atomic.LoadInt32(requests); got != 1 {
+		t.Errorf("expected zones to be listed once, got %d requests", got)
+	}
+}
+
+func TestZoneRegistryTimeout(t *testing.T) {
+	release := make(chan struct{})
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}, ClientOptWithZoneRegistry(NewZoneRegistry(ZoneRegistryOptWithTimeout(10*time.Millisecond))))
+	// Registered after the test server cleanup, to run first.
+	t.Cleanup(func() { close(release) })
+
+	if _, err := client.GetZoneAPIEndpoint(context.Background(), ZoneNameCHGva2); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected %v, got %v", context.DeadlineExceeded, err)
+	}
+}
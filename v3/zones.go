@@ -0,0 +1,174 @@
+package v3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// defaultZonesParallelism is the default number of zones ForEachZone runs concurrently.
+const defaultZonesParallelism = 4
+
+// ZoneResult represents the result of an operation run against a zone.
+type ZoneResult[T any] struct {
+	Zone  ZoneName
+	Value T
+}
+
+// ZonesError is the error returned by ForEachZone when the operation failed in some zones.
+type ZonesError struct {
+	// Errors is the error of each failed zone.
+	Errors map[ZoneName]error
+}
+
+func (e *ZonesError) Error() string {
+	zones := make([]string, 0, len(e.Errors))
+	for zone := range e.Errors {
+		zones = append(zones, string(zone))
+	}
+	slices.Sort(zones)
+
+	msgs := make([]string, 0, len(zones))
+	for _, zone := range zones {
+		msgs = append(msgs, fmt.Sprintf("%s: %v", zone, e.Errors[ZoneName(zone)]))
+	}
+
+	return strings.Join(msgs, "; ")
+}
+
+func (e *ZonesError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+type forEachZoneConfig struct {
+	zones       []ZoneName
+	parallelism int
+}
+
+// ForEachZoneOpt represents a ForEachZone option.
+type ForEachZoneOpt func(*forEachZoneConfig)
+
+// ForEachZoneOptWithZones returns a ForEachZoneOpt restricting the operation to the given zones.
+func ForEachZoneOptWithZones(zones ...ZoneName) ForEachZoneOpt {
+	return func(c *forEachZoneConfig) {
+		c.zones = append(c.zones, zones...)
+	}
+}
+
+// ForEachZoneOptWithParallelism returns a ForEachZoneOpt setting the maximum number
+// of zones the operation runs against concurrently.
+func ForEachZoneOptWithParallelism(n int) ForEachZoneOpt {
+	return func(c *forEachZoneConfig) {
+		c.parallelism = n
+	}
+}
+
+// ForEachZone runs fn concurrently against every zone (or the zones set with ForEachZoneOptWithZones),
+// passing a copy of client targeting the zone API endpoint, and returns the results tagged by zone name
+// in the order of the zones. If fn fails in some zones, the results of the other zones are returned
+// along with a *ZonesError reporting the error of each failed zone.
+//
+// Example:
+//
+//	results, err := v3.ForEachZone(ctx, client, func(ctx context.Context, c *v3.Client) (*v3.ListInstancesResponse, error) {
+//		return c.ListInstances(ctx)
+//	})
+func ForEachZone[T any](
+	ctx context.Context,
+	client *Client,
+	fn func(ctx context.Context, c *Client) (T, error),
+	opts ...ForEachZoneOpt,
+) ([]ZoneResult[T], error) {
+	config := forEachZoneConfig{parallelism: defaultZonesParallelism}
+	for _, opt := range opts {
+		opt(&config)
+	}
+	if config.parallelism < 1 {
+		config.parallelism = 1
+	}
+
+	resp, fetched, err := client.listZones(ctx, false)
+	if err != nil {
+		return nil, fmt.Errorf("for each zone: list zones: %w", err)
+	}
+	if !fetched && slices.ContainsFunc(config.zones, func(name ZoneName) bool {
+		_, err := resp.FindZone(string(name))
+		return errors.Is(err, ErrNotFound)
+	}) {
+		// A zone may have been added since the zones were cached, as in findZone.
+		if resp, _, err = client.listZones(ctx, true); err != nil {
+			return nil, fmt.Errorf("for each zone: list zones: %w", err)
+		}
+	}
+
+	zones := config.zones
+	if len(zones) == 0 {
+		for _, zone := range resp.Zones {
+			zones = append(zones, zone.Name)
+		}
+	}
+
+	var (
+		results = make([]*ZoneResult[T], len(zones))
+		zoneErr = &ZonesError{Errors: make(map[ZoneName]error)}
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		sem     = make(chan struct{}, config.parallelism)
+	)
+
+	for i, name := range zones {
+		zone, err := resp.FindZone(string(name))
+		if err != nil {
+			mu.Lock()
+			zoneErr.Errors[name] = err
+			mu.Unlock()
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				mu.Lock()
+				zoneErr.Errors[zone.Name] = ctx.Err()
+				mu.Unlock()
+				return
+			}
+
+			value, err := fn(ctx, client.WithEndpoint(zone.APIEndpoint))
+			if err != nil {
+				mu.Lock()
+				zoneErr.Errors[zone.Name] = err
+				mu.Unlock()
+				return
+			}
+
+			results[i] = &ZoneResult[T]{Zone: zone.Name, Value: value}
+		}()
+	}
+	wg.Wait()
+
+	values := make([]ZoneResult[T], 0, len(results))
+	for _, result := range results {
+		if result != nil {
+			values = append(values, *result)
+		}
+	}
+
+	if len(zoneErr.Errors) > 0 {
+		return values, zoneErr
+	}
+
+	return values, nil
+}
@@ -0,0 +1,145 @@
+package v3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newTestZonesClient returns a Client listing a zone per handler, each served by its own test server.
+func newTestZonesClient(t *testing.T, handlers map[ZoneName]http.HandlerFunc) *Client {
+	t.Helper()
+
+	var zones []Zone
+	for name, handler := range handlers {
+		ts := httptest.NewServer(handler)
+		t.Cleanup(ts.Close)
+		zones = append(zones, Zone{Name: name, APIEndpoint: Endpoint(ts.URL)})
+	}
+
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, `{"zones":[`)
+		for i, zone := range zones {
+			if i > 0 {
+				_, _ = fmt.Fprint(w, ",")
+			}
+			_, _ = fmt.Fprintf(w, `{"name":%q,"api-endpoint":%q}`, zone.Name, zone.APIEndpoint)
+		}
+		_, _ = fmt.Fprint(w, `]}`)
+	})
+
+	return client
+}
+
+func TestForEachZone(t *testing.T) {
+	var inflight, maxInflight int32
+	instances := func(id string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			n := atomic.AddInt32(&inflight, 1)
+			defer atomic.AddInt32(&inflight, -1)
+			for {
+				m := atomic.LoadInt32(&maxInflight)
+				if n <= m || atomic.CompareAndSwapInt32(&maxInflight, m, n) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = fmt.Fprintf(w, `{"instances":[{"id":%q}]}`, id)
+		}
+	}
+
+	client := newTestZonesClient(t, map[ZoneName]http.HandlerFunc{
+		ZoneNameCHGva2: instances("a0ae7bc4-0d7c-4c8f-9a2e-0a3f3b8f1c01"),
+		ZoneNameDEFra1: instances("a0ae7bc4-0d7c-4c8f-9a2e-0a3f3b8f1c02"),
+		ZoneNameATVie1: func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		},
+	})
+
+	listInstances := func(ctx context.Context, c *Client) (*ListInstancesResponse, error) {
+		return c.ListInstances(ctx)
+	}
+
+	results, err := ForEachZone(context.Background(), client, listInstances,
+		ForEachZoneOptWithZones(ZoneNameDEFra1, ZoneNameCHGva2, ZoneNameATVie1, ZoneNameBGSof1),
+		ForEachZoneOptWithParallelism(1),
+	)
+
+	var zerr *ZonesError
+	if !errors.As(err, &zerr) {
+		t.Fatalf("expected a *ZonesError, got %v", err)
+	}
+	if len(zerr.Errors) != 2 ||
+		!errors.Is(zerr.Errors[ZoneNameATVie1], ErrInternalServerError) ||
+		!errors.Is(zerr.Errors[ZoneNameBGSof1], ErrNotFound) {
+		t.Errorf("unexpected zone errors: %v", err)
+	}
+
+	var got []ZoneName
+	for _, result := range results {
+		got = append(got, result.Zone)
+		if len(result.Value.Instances) != 1 {
+			t.Errorf("%s: unexpected result %+v", result.Zone, result.Value)
+		}
+	}
+	if !reflect.DeepEqual(got, []ZoneName{ZoneNameDEFra1, ZoneNameCHGva2}) {
+		t.Errorf("unexpected zones %v", got)
+	}
+	if results[0].Value.Instances[0].ID != "a0ae7bc4-0d7c-4c8f-9a2e-0a3f3b8f1c02" {
+		t.Errorf("unexpected result for %s: %+v", results[0].Zone, results[0].Value)
+	}
+	if m := atomic.LoadInt32(&maxInflight); m != 1 {
+		t.Errorf("expected parallelism to be bounded to 1, got %d", m)
+	}
+
+	results, err = ForEachZone(context.Background(), client, listInstances,
+		ForEachZoneOptWithZones(ZoneNameCHGva2, ZoneNameDEFra1),
+	)
+	if err != nil || len(results) != 2 {
+		t.Errorf("expected 2 results without error, got %d and %v", len(results), err)
+	}
+}
+
+func TestForEachZoneNewZone(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, `{"instances":[]}`)
+	}))
+	t.Cleanup(ts.Close)
+
+	var added atomic.Bool
+	client, requests := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if !added.Load() {
+			_, _ = fmt.Fprintf(w, `{"zones":[{"name":"ch-gva-2","api-endpoint":%q}]}`, ts.URL)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"zones":[{"name":"ch-gva-2","api-endpoint":%q},{"name":"xx-new-1","api-endpoint":%q}]}`, ts.URL, ts.URL)
+	}, ClientOptWithZoneRegistry(NewZoneRegistry()))
+	ctx := context.Background()
+
+	if _, err := client.GetZoneAPIEndpoint(ctx, ZoneNameCHGva2); err != nil {
+		t.Fatal(err)
+	}
+
+	// The zones are fetched again for a zone missing from the cached ones.
+	added.Store(true)
+	results, err := ForEachZone(ctx, client, func(ctx context.Context, c *Client) (*ListInstancesResponse, error) {
+		return c.ListInstances(ctx)
+	}, ForEachZoneOptWithZones(ZoneNameCHGva2, "xx-new-1"))
+	if err != nil || len(results) != 2 {
+		t.Fatalf("expected 2 results without error, got %d and %v", len(results), err)
+	}
+	if got := atomic.LoadInt32(requests); got != 2 {
+		t.Errorf("expected zones to be listed twice, got %d requests", got)
+	}
+}